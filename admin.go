@@ -0,0 +1,191 @@
+package gotelegrambot
+
+import (
+	"context"
+)
+
+// Chat member statuses.
+const (
+	ChatMemberStatusCreator       = "creator"
+	ChatMemberStatusAdministrator = "administrator"
+	ChatMemberStatusMember        = "member"
+	ChatMemberStatusRestricted    = "restricted"
+	ChatMemberStatusLeft          = "left"
+	ChatMemberStatusKicked        = "kicked"
+)
+
+// IsMember reports whether the user is currently in the chat.
+func (m *ChatMember) IsMember() bool {
+	switch m.Status {
+	case ChatMemberStatusCreator, ChatMemberStatusAdministrator, ChatMemberStatusMember:
+		return true
+	case ChatMemberStatusRestricted:
+		return m.Restricted != nil && m.Restricted.IsMember
+	}
+	return false
+}
+
+// IsAdministrator reports whether the user is the owner or an administrator of the chat.
+func (m *ChatMember) IsAdministrator() bool {
+	return m.Status == ChatMemberStatusCreator || m.Status == ChatMemberStatusAdministrator
+}
+
+// IsRestricted reports whether the user is under restrictions in the chat.
+func (m *ChatMember) IsRestricted() bool {
+	return m.Status == ChatMemberStatusRestricted
+}
+
+// IsBanned reports whether the user is banned from the chat.
+func (m *ChatMember) IsBanned() bool {
+	return m.Status == ChatMemberStatusKicked
+}
+
+// UntilDate returns the date when restrictions or the ban will be lifted, zero meaning forever.
+func (m *ChatMember) UntilDate() int {
+	switch {
+	case m.Restricted != nil:
+		return m.Restricted.UntilDate
+	case m.Banned != nil:
+		return m.Banned.UntilDate
+	case m.Member != nil:
+		return m.Member.UntilDate
+	}
+	return 0
+}
+
+// BanChatMember bans a user in a group, a supergroup or a channel.
+func (b *Bot) BanChatMember(ctx context.Context, chatID ChatID, userID int64, options ...BanChatMemberOption) error {
+	params := BanChatMemberParams{
+		ChatID: chatID,
+		UserID: userID,
+	}
+	for _, opt := range options {
+		opt(&params)
+	}
+
+	return params.do(ctx, b)
+}
+
+// BanChatMemberOption is a function that configures BanChatMember options.
+type BanChatMemberOption func(*BanChatMemberParams)
+
+// WithBanUntilDate sets the date when the user will be unbanned, as a Unix time.
+func WithBanUntilDate(untilDate int) BanChatMemberOption {
+	return func(p *BanChatMemberParams) {
+		p.UntilDate = untilDate
+	}
+}
+
+// WithRevokeMessages deletes all messages from the chat for the user that is being removed.
+func WithRevokeMessages(revoke bool) BanChatMemberOption {
+	return func(p *BanChatMemberParams) {
+		p.RevokeMessages = revoke
+	}
+}
+
+// UnbanChatMember unbans a previously banned user. With onlyIfBanned set, a user
+// that is currently a member is not removed from the chat.
+func (b *Bot) UnbanChatMember(ctx context.Context, chatID ChatID, userID int64, onlyIfBanned bool) error {
+	params := UnbanChatMemberParams{
+		ChatID:       chatID,
+		UserID:       userID,
+		OnlyIfBanned: onlyIfBanned,
+	}
+
+	return params.do(ctx, b)
+}
+
+// RestrictChatMember restricts a user in a supergroup.
+func (b *Bot) RestrictChatMember(ctx context.Context, chatID ChatID, userID int64, permissions ChatPermissions, options ...RestrictChatMemberOption) error {
+	params := RestrictChatMemberParams{
+		ChatID:      chatID,
+		UserID:      userID,
+		Permissions: &permissions,
+	}
+	for _, opt := range options {
+		opt(&params)
+	}
+
+	return params.do(ctx, b)
+}
+
+// RestrictChatMemberOption is a function that configures RestrictChatMember options.
+type RestrictChatMemberOption func(*RestrictChatMemberParams)
+
+// WithIndependentChatPermissions applies each permission on its own instead of
+// letting the media permissions imply can_send_messages and the like.
+func WithIndependentChatPermissions(independent bool) RestrictChatMemberOption {
+	return func(p *RestrictChatMemberParams) {
+		p.UseIndependentChatPermissions = independent
+	}
+}
+
+// WithRestrictUntilDate sets the date when restrictions will be lifted, as a Unix time.
+func WithRestrictUntilDate(untilDate int) RestrictChatMemberOption {
+	return func(p *RestrictChatMemberParams) {
+		p.UntilDate = untilDate
+	}
+}
+
+// PromoteChatMember promotes or demotes a user in a supergroup or a channel.
+// Passing rights with every flag unset demotes the user.
+func (b *Bot) PromoteChatMember(ctx context.Context, chatID ChatID, userID int64, rights ChatAdministratorRights) error {
+	params := PromoteChatMemberParams{
+		ChatID:              chatID,
+		UserID:              userID,
+		IsAnonymous:         rights.IsAnonymous,
+		CanManageChat:       rights.CanManageChat,
+		CanDeleteMessages:   rights.CanDeleteMessages,
+		CanManageVideoChats: rights.CanManageVideoChats,
+		CanRestrictMembers:  rights.CanRestrictMembers,
+		CanPromoteMembers:   rights.CanPromoteMembers,
+		CanChangeInfo:       rights.CanChangeInfo,
+		CanInviteUsers:      rights.CanInviteUsers,
+		CanPostStories:      rights.CanPostStories,
+		CanEditStories:      rights.CanEditStories,
+		CanDeleteStories:    rights.CanDeleteStories,
+		CanPostMessages:     rights.CanPostMessages,
+		CanEditMessages:     rights.CanEditMessages,
+		CanPinMessages:      rights.CanPinMessages,
+		CanManageTopics:     rights.CanManageTopics,
+	}
+
+	return params.do(ctx, b)
+}
+
+// SetChatAdministratorCustomTitle sets a custom title for an administrator in a supergroup promoted by the bot.
+func (b *Bot) SetChatAdministratorCustomTitle(ctx context.Context, chatID ChatID, userID int64, customTitle string) error {
+	params := SetChatAdministratorCustomTitleParams{
+		ChatID:      chatID,
+		UserID:      userID,
+		CustomTitle: customTitle,
+	}
+
+	return params.do(ctx, b)
+}
+
+// SetChatPermissions sets default chat permissions for all members.
+func (b *Bot) SetChatPermissions(ctx context.Context, chatID ChatID, permissions ChatPermissions, useIndependentChatPermissions bool) error {
+	params := SetChatPermissionsParams{
+		ChatID:                        chatID,
+		Permissions:                   &permissions,
+		UseIndependentChatPermissions: useIndependentChatPermissions,
+	}
+
+	return params.do(ctx, b)
+}
+
+// GetChatAdministrators gets the administrators of a chat, which aren't bots.
+func (b *Bot) GetChatAdministrators(ctx context.Context, chatID ChatID) ([]ChatMember, error) {
+	return GetChatAdministratorsParams{ChatID: chatID}.do(ctx, b)
+}
+
+// GetChatMember gets information about a member of a chat.
+func (b *Bot) GetChatMember(ctx context.Context, chatID ChatID, userID int64) (*ChatMember, error) {
+	return GetChatMemberParams{ChatID: chatID, UserID: userID}.do(ctx, b)
+}
+
+// GetChatMemberCount gets the number of members in a chat.
+func (b *Bot) GetChatMemberCount(ctx context.Context, chatID ChatID) (int, error) {
+	return GetChatMemberCountParams{ChatID: chatID}.do(ctx, b)
+}
@@ -0,0 +1,98 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatMemberUnmarshal(t *testing.T) {
+	data := `[
+		{"status":"creator","user":{"id":1,"is_bot":false,"first_name":"Owner"},"is_anonymous":false},
+		{"status":"administrator","user":{"id":2,"is_bot":false,"first_name":"Admin"},"can_be_edited":true,"can_delete_messages":true,"custom_title":"Mod"},
+		{"status":"restricted","user":{"id":3,"is_bot":false,"first_name":"Muted"},"is_member":true,"can_send_messages":false,"can_send_photos":true,"until_date":1700000000},
+		{"status":"kicked","user":{"id":4,"is_bot":false,"first_name":"Banned"},"until_date":0}
+	]`
+
+	var members []ChatMember
+	require.NoError(t, json.Unmarshal([]byte(data), &members))
+	require.Len(t, members, 4)
+
+	assert.NotNil(t, members[0].Owner)
+	assert.True(t, members[0].IsAdministrator())
+
+	require.NotNil(t, members[1].Administrator)
+	assert.True(t, members[1].Administrator.CanDeleteMessages)
+	assert.Equal(t, "Mod", members[1].Administrator.CustomTitle)
+	assert.Equal(t, int64(2), members[1].User.ID)
+
+	require.NotNil(t, members[2].Restricted)
+	assert.True(t, members[2].IsMember())
+	assert.True(t, members[2].Restricted.CanSendPhotos)
+	assert.Equal(t, 1700000000, members[2].UntilDate())
+
+	assert.True(t, members[3].IsBanned())
+	assert.False(t, members[3].IsMember())
+
+	// Round trip keeps the variant fields
+	encoded, err := json.Marshal(members[1])
+	require.NoError(t, err)
+	var decoded ChatMember
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, members[1], decoded)
+}
+
+func TestChatMemberUpdatedChange(t *testing.T) {
+	member := func(status string) *ChatMember {
+		m := &ChatMember{Status: status}
+		if status == ChatMemberStatusRestricted {
+			m.Restricted = &ChatMemberRestricted{Status: status, IsMember: true}
+		}
+		return m
+	}
+
+	tests := []struct {
+		old, new string
+		want     MemberChange
+	}{
+		{ChatMemberStatusLeft, ChatMemberStatusMember, MemberChangeJoined},
+		{ChatMemberStatusMember, ChatMemberStatusLeft, MemberChangeLeft},
+		{ChatMemberStatusMember, ChatMemberStatusKicked, MemberChangeBanned},
+		{ChatMemberStatusKicked, ChatMemberStatusLeft, MemberChangeUnbanned},
+		{ChatMemberStatusMember, ChatMemberStatusAdministrator, MemberChangePromoted},
+		{ChatMemberStatusAdministrator, ChatMemberStatusMember, MemberChangeDemoted},
+		{ChatMemberStatusMember, ChatMemberStatusRestricted, MemberChangeRestricted},
+		{ChatMemberStatusRestricted, ChatMemberStatusMember, MemberChangeUnrestricted},
+		{ChatMemberStatusAdministrator, ChatMemberStatusAdministrator, MemberChangeUpdated},
+	}
+
+	for _, tt := range tests {
+		update := &ChatMemberUpdated{OldChatMember: member(tt.old), NewChatMember: member(tt.new)}
+		assert.Equal(t, tt.want, update.Change(), "%s -> %s", tt.old, tt.new)
+	}
+}
+
+func TestAllowedUpdates(t *testing.T) {
+	bot, _ := New("test_token")
+	assert.Empty(t, bot.allowedUpdates(nil))
+
+	// my_chat_member is delivered by default, so nothing needs to change
+	bot.OnBotAddedToGroup(func(ctx context.Context, update *ChatMemberUpdated) error { return nil })
+	assert.Empty(t, bot.allowedUpdates(nil))
+	assert.Equal(t, []string{"message", "my_chat_member"}, bot.allowedUpdates([]string{"message"}))
+
+	// chat_member must be requested explicitly
+	bot.OnUserJoined(func(ctx context.Context, update *ChatMemberUpdated) error { return nil })
+	allowed := bot.allowedUpdates(nil)
+	assert.Equal(t, []string{
+		"message", "edited_message", "channel_post", "edited_channel_post",
+		"business_connection", "business_message", "edited_business_message", "deleted_business_messages",
+		"inline_query", "chosen_inline_result", "callback_query", "shipping_query", "pre_checkout_query",
+		"purchased_paid_media", "poll", "poll_answer", "my_chat_member", "chat_join_request",
+		"chat_boost", "removed_chat_boost", "chat_member",
+	}, allowed)
+	assert.Equal(t, []string{"message", "my_chat_member", "chat_member"}, bot.allowedUpdates([]string{"message"}))
+}
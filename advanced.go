@@ -2,234 +2,192 @@ package gotelegrambot
 
 import (
 	"context"
+	"strings"
 )
 
-// CallbackQuery represents an incoming callback query from a callback button in an inline keyboard.
-type CallbackQuery struct {
-	ID              string   `json:"id"`
-	From            *User    `json:"from"`
-	Message         *Message `json:"message,omitempty"`
-	InlineMessageID string   `json:"inline_message_id,omitempty"`
-	ChatInstance    string   `json:"chat_instance"`
-	Data            string   `json:"data,omitempty"`
-	GameShortName   string   `json:"game_short_name,omitempty"`
-}
-
 // AnswerCallbackQuery sends an answer to a callback query.
 func (b *Bot) AnswerCallbackQuery(ctx context.Context, callbackQueryID string, options ...AnswerCallbackQueryOption) error {
-	params := map[string]interface{}{
-		"callback_query_id": callbackQueryID,
+	params := AnswerCallbackQueryParams{
+		CallbackQueryID: callbackQueryID,
 	}
-	
-	opts := defaultAnswerCallbackQueryOptions()
 	for _, opt := range options {
-		opt(&opts)
-	}
-	
-	if opts.Text != "" {
-		params["text"] = opts.Text
-	}
-	
-	if opts.ShowAlert {
-		params["show_alert"] = true
-	}
-	
-	if opts.URL != "" {
-		params["url"] = opts.URL
-	}
-	
-	if opts.CacheTime != 0 {
-		params["cache_time"] = opts.CacheTime
+		opt(&params)
 	}
-	
-	return b.makeRequest(ctx, "answerCallbackQuery", params, nil)
+
+	return params.do(ctx, b)
 }
 
 // AnswerCallbackQueryOption is a function that configures AnswerCallbackQuery options.
-type AnswerCallbackQueryOption func(*answerCallbackQueryOptions)
-
-// answerCallbackQueryOptions represents options for AnswerCallbackQuery.
-type answerCallbackQueryOptions struct {
-	Text      string
-	ShowAlert bool
-	URL       string
-	CacheTime int
-}
+type AnswerCallbackQueryOption func(*AnswerCallbackQueryParams)
 
 // WithCallbackText sets the text for the answer.
 func WithCallbackText(text string) AnswerCallbackQueryOption {
-	return func(o *answerCallbackQueryOptions) {
-		o.Text = text
+	return func(p *AnswerCallbackQueryParams) {
+		p.Text = text
 	}
 }
 
 // WithShowAlert sets whether to show an alert instead of a notification.
 func WithShowAlert(showAlert bool) AnswerCallbackQueryOption {
-	return func(o *answerCallbackQueryOptions) {
-		o.ShowAlert = showAlert
+	return func(p *AnswerCallbackQueryParams) {
+		p.ShowAlert = showAlert
 	}
 }
 
 // WithCallbackURL sets the URL to open.
 func WithCallbackURL(url string) AnswerCallbackQueryOption {
-	return func(o *answerCallbackQueryOptions) {
-		o.URL = url
+	return func(p *AnswerCallbackQueryParams) {
+		p.URL = url
 	}
 }
 
 // WithCallbackCacheTime sets the cache time for the answer.
 func WithCallbackCacheTime(cacheTime int) AnswerCallbackQueryOption {
-	return func(o *answerCallbackQueryOptions) {
-		o.CacheTime = cacheTime
+	return func(p *AnswerCallbackQueryParams) {
+		p.CacheTime = cacheTime
 	}
 }
 
-func defaultAnswerCallbackQueryOptions() answerCallbackQueryOptions {
-	return answerCallbackQueryOptions{}
+// CallbackQueryHandler is a function that handles a callback query.
+type CallbackQueryHandler func(ctx context.Context, query *CallbackQuery) error
+
+// callbackRoute is a CallbackQueryHandler registered for a callback data prefix.
+type callbackRoute struct {
+	prefix  string
+	handler CallbackQueryHandler
 }
 
-// EditMessageText edits a text message.
-func (b *Bot) EditMessageText(ctx context.Context, options ...EditMessageTextOption) (*Message, error) {
-	params := map[string]interface{}{}
-	
-	opts := defaultEditMessageTextOptions()
-	for _, opt := range options {
-		opt(&opts)
-	}
-	
-	// Fill in params from options
-	if opts.ChatID != 0 {
-		params["chat_id"] = opts.ChatID
-	}
+// OnCallbackQuery registers a handler for callback queries whose data starts with prefix.
+// Routes run before the update handler, which still receives the update.
+func (b *Bot) OnCallbackQuery(prefix string, handler CallbackQueryHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
 	
-	if opts.MessageID != 0 {
-		params["message_id"] = opts.MessageID
-	}
-	
-	if opts.InlineMessageID != "" {
-		params["inline_message_id"] = opts.InlineMessageID
-	}
-	
-	params["text"] = opts.Text
-	
-	if opts.ParseMode != "" {
-		params["parse_mode"] = opts.ParseMode
-	}
-	
-	if len(opts.Entities) > 0 {
-		params["entities"] = opts.Entities
+	b.callbackRoutes = append(b.callbackRoutes, callbackRoute{
+		prefix:  prefix,
+		handler: handler,
+	})
+}
+
+// runCallbackRoutes calls the first route matching the callback query of the update.
+func (b *Bot) runCallbackRoutes(ctx context.Context, update *Update) error {
+	if update.CallbackQuery == nil {
+		return nil
 	}
 	
-	if opts.DisableWebPagePreview {
-		params["disable_web_page_preview"] = true
-	}
+	b.mu.RLock()
+	routes := b.callbackRoutes
+	b.mu.RUnlock()
 	
-	if opts.ReplyMarkup != nil {
-		params["reply_markup"] = opts.ReplyMarkup
+	for _, route := range routes {
+		if strings.HasPrefix(update.CallbackQuery.Data, route.prefix) {
+			return route.handler(ctx, update.CallbackQuery)
+		}
 	}
 	
-	var message Message
-	err := b.makeRequest(ctx, "editMessageText", params, &message)
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+// EditMessageText edits a text message. The edited message is identified by
+// WithChatID and WithMessageID, or by WithInlineMessageID, in which case the
+// returned message is nil.
+func (b *Bot) EditMessageText(ctx context.Context, options ...EditMessageTextOption) (*Message, error) {
+	var params EditMessageTextParams
+	for _, opt := range options {
+		opt(&params)
 	}
-	
-	return &message, nil
+
+	return params.do(ctx, b)
 }
 
 // EditMessageTextOption is a function that configures EditMessageText options.
-type EditMessageTextOption func(*editMessageTextOptions)
-
-// editMessageTextOptions represents options for EditMessageText.
-type editMessageTextOptions struct {
-	ChatID                int64
-	MessageID             int
-	InlineMessageID       string
-	Text                  string
-	ParseMode             string
-	Entities              []MessageEntity
-	DisableWebPagePreview bool
-	ReplyMarkup           interface{}
-}
+type EditMessageTextOption func(*EditMessageTextParams)
 
 // WithChatID sets the chat ID for editing a message.
-func WithChatID(chatID int64) EditMessageTextOption {
-	return func(o *editMessageTextOptions) {
-		o.ChatID = chatID
+func WithChatID(chatID ChatID) EditMessageTextOption {
+	return func(p *EditMessageTextParams) {
+		p.ChatID = chatID
 	}
 }
 
 // WithMessageID sets the message ID for editing a message.
 func WithMessageID(messageID int) EditMessageTextOption {
-	return func(o *editMessageTextOptions) {
-		o.MessageID = messageID
+	return func(p *EditMessageTextParams) {
+		p.MessageID = messageID
 	}
 }
 
 // WithInlineMessageID sets the inline message ID for editing a message.
 func WithInlineMessageID(inlineMessageID string) EditMessageTextOption {
-	return func(o *editMessageTextOptions) {
-		o.InlineMessageID = inlineMessageID
+	return func(p *EditMessageTextParams) {
+		p.InlineMessageID = inlineMessageID
 	}
 }
 
 // WithText sets the text for editing a message.
 func WithText(text string) EditMessageTextOption {
-	return func(o *editMessageTextOptions) {
-		o.Text = text
+	return func(p *EditMessageTextParams) {
+		p.Text = text
 	}
 }
 
 // WithEditParseMode sets the parse mode for editing a message.
 func WithEditParseMode(parseMode string) EditMessageTextOption {
-	return func(o *editMessageTextOptions) {
-		o.ParseMode = parseMode
+	return func(p *EditMessageTextParams) {
+		p.ParseMode = parseMode
 	}
 }
 
 // WithEditEntities sets the entities for editing a message.
 func WithEditEntities(entities []MessageEntity) EditMessageTextOption {
-	return func(o *editMessageTextOptions) {
-		o.Entities = entities
+	return func(p *EditMessageTextParams) {
+		p.Entities = entities
 	}
 }
 
 // WithEditDisableWebPagePreview disables link previews for editing a message.
 func WithEditDisableWebPagePreview(disable bool) EditMessageTextOption {
-	return func(o *editMessageTextOptions) {
-		o.DisableWebPagePreview = disable
+	return func(p *EditMessageTextParams) {
+		if p.LinkPreviewOptions == nil {
+			p.LinkPreviewOptions = &LinkPreviewOptions{}
+		}
+		p.LinkPreviewOptions.IsDisabled = disable
 	}
 }
 
-// WithEditReplyMarkup sets the reply markup for editing a message.
-func WithEditReplyMarkup(markup interface{}) EditMessageTextOption {
-	return func(o *editMessageTextOptions) {
-		o.ReplyMarkup = markup
+// WithEditReplyMarkup sets the inline keyboard of the edited message.
+func WithEditReplyMarkup(markup *InlineKeyboardMarkup) EditMessageTextOption {
+	return func(p *EditMessageTextParams) {
+		p.ReplyMarkup = markup
 	}
 }
 
-func defaultEditMessageTextOptions() editMessageTextOptions {
-	return editMessageTextOptions{}
+// DeleteMessage deletes a message.
+func (b *Bot) DeleteMessage(ctx context.Context, chatID ChatID, messageID int) error {
+	return DeleteMessageParams{ChatID: chatID, MessageID: messageID}.do(ctx, b)
 }
 
-// DeleteMessage deletes a message.
-func (b *Bot) DeleteMessage(ctx context.Context, chatID interface{}, messageID int) error {
-	params := map[string]interface{}{
-		"chat_id":    chatID,
-		"message_id": messageID,
+// SendChatAction sends a chat action.
+func (b *Bot) SendChatAction(ctx context.Context, chatID ChatID, action string, options ...ChatActionOption) error {
+	params := SendChatActionParams{
+		ChatID: chatID,
+		Action: action,
 	}
-	
-	return b.makeRequest(ctx, "deleteMessage", params, nil)
+	for _, opt := range options {
+		opt(&params)
+	}
+
+	return params.do(ctx, b)
 }
 
-// SendChatAction sends a chat action.
-func (b *Bot) SendChatAction(ctx context.Context, chatID interface{}, action string) error {
-	params := map[string]interface{}{
-		"chat_id": chatID,
-		"action":  action,
+// ChatActionOption is a function that configures SendChatAction options.
+type ChatActionOption func(*SendChatActionParams)
+
+// WithChatActionMessageThreadID shows the action in a forum topic. Forum supergroups only.
+func WithChatActionMessageThreadID(messageThreadID int) ChatActionOption {
+	return func(p *SendChatActionParams) {
+		p.MessageThreadID = messageThreadID
 	}
-	
-	return b.makeRequest(ctx, "sendChatAction", params, nil)
 }
 
 // ChatActionType represents the type of chat action.
@@ -248,147 +206,69 @@ const (
 )
 
 // ForwardMessage forwards a message.
-func (b *Bot) ForwardMessage(ctx context.Context, chatID interface{}, fromChatID interface{}, messageID int, options ...ForwardMessageOption) (*Message, error) {
-	params := map[string]interface{}{
-		"chat_id":      chatID,
-		"from_chat_id": fromChatID,
-		"message_id":   messageID,
+func (b *Bot) ForwardMessage(ctx context.Context, chatID ChatID, fromChatID ChatID, messageID int, options ...ForwardMessageOption) (*Message, error) {
+	params := ForwardMessageParams{
+		ChatID:     chatID,
+		FromChatID: fromChatID,
+		MessageID:  messageID,
 	}
-	
-	opts := defaultForwardMessageOptions()
 	for _, opt := range options {
-		opt(&opts)
-	}
-	
-	if opts.DisableNotification {
-		params["disable_notification"] = true
-	}
-	
-	if opts.ProtectContent {
-		params["protect_content"] = true
+		opt(&params)
 	}
-	
-	var message Message
-	err := b.makeRequest(ctx, "forwardMessage", params, &message)
-	if err != nil {
-		return nil, err
-	}
-	
-	return &message, nil
+
+	return params.do(ctx, b)
 }
 
 // ForwardMessageOption is a function that configures ForwardMessage options.
-type ForwardMessageOption func(*forwardMessageOptions)
+type ForwardMessageOption func(*ForwardMessageParams)
 
-// forwardMessageOptions represents options for ForwardMessage.
-type forwardMessageOptions struct {
-	DisableNotification bool
-	ProtectContent      bool
+// WithForwardMessageThreadID forwards the message to a forum topic. Forum supergroups only.
+func WithForwardMessageThreadID(messageThreadID int) ForwardMessageOption {
+	return func(p *ForwardMessageParams) {
+		p.MessageThreadID = messageThreadID
+	}
 }
 
 // WithForwardDisableNotification disables notifications for forwarding a message.
 func WithForwardDisableNotification(disable bool) ForwardMessageOption {
-	return func(o *forwardMessageOptions) {
-		o.DisableNotification = disable
+	return func(p *ForwardMessageParams) {
+		p.DisableNotification = disable
 	}
 }
 
 // WithForwardProtectContent protects the content of the forwarded message.
 func WithForwardProtectContent(protect bool) ForwardMessageOption {
-	return func(o *forwardMessageOptions) {
-		o.ProtectContent = protect
+	return func(p *ForwardMessageParams) {
+		p.ProtectContent = protect
 	}
 }
 
-func defaultForwardMessageOptions() forwardMessageOptions {
-	return forwardMessageOptions{}
-}
-
 // CopyMessage copies a message.
-func (b *Bot) CopyMessage(ctx context.Context, chatID interface{}, fromChatID interface{}, messageID int, options ...CopyMessageOption) (*MessageID, error) {
-	params := map[string]interface{}{
-		"chat_id":      chatID,
-		"from_chat_id": fromChatID,
-		"message_id":   messageID,
+func (b *Bot) CopyMessage(ctx context.Context, chatID ChatID, fromChatID ChatID, messageID int, options ...CopyMessageOption) (*MessageID, error) {
+	params := CopyMessageParams{
+		ChatID:     chatID,
+		FromChatID: fromChatID,
+		MessageID:  messageID,
 	}
-	
-	opts := defaultCopyMessageOptions()
 	for _, opt := range options {
-		opt(&opts)
-	}
-	
-	// Fill in params from options
-	if opts.Caption != "" {
-		params["caption"] = opts.Caption
+		opt(&params)
 	}
-	
-	if opts.ParseMode != "" {
-		params["parse_mode"] = opts.ParseMode
-	}
-	
-	if len(opts.CaptionEntities) > 0 {
-		params["caption_entities"] = opts.CaptionEntities
-	}
-	
-	if opts.DisableNotification {
-		params["disable_notification"] = true
-	}
-	
-	if opts.ProtectContent {
-		params["protect_content"] = true
-	}
-	
-	if opts.ReplyToMessageID != 0 {
-		params["reply_to_message_id"] = opts.ReplyToMessageID
-	}
-	
-	if opts.AllowSendingWithoutReply {
-		params["allow_sending_without_reply"] = true
-	}
-	
-	if opts.ReplyMarkup != nil {
-		params["reply_markup"] = opts.ReplyMarkup
-	}
-	
-	var messageID MessageID
-	err := b.makeRequest(ctx, "copyMessage", params, &messageID)
-	if err != nil {
-		return nil, err
-	}
-	
-	return &messageID, nil
-}
 
-// MessageID represents a unique message identifier.
-type MessageID struct {
-	MessageID int `json:"message_id"`
+	return params.do(ctx, b)
 }
 
 // CopyMessageOption is a function that configures CopyMessage options.
-type CopyMessageOption func(*copyMessageOptions)
-
-// copyMessageOptions represents options for CopyMessage.
-type copyMessageOptions struct {
-	Caption                  string
-	ParseMode                string
-	CaptionEntities          []MessageEntity
-	DisableNotification      bool
-	ProtectContent           bool
-	ReplyToMessageID         int
-	AllowSendingWithoutReply bool
-	ReplyMarkup              interface{}
-}
+type CopyMessageOption func(*CopyMessageParams)
 
-// Various option functions for CopyMessage would be defined here,
-// similar to the other option functions above.
-
-func defaultCopyMessageOptions() copyMessageOptions {
-	return copyMessageOptions{}
+// WithCopyMessageThreadID sends the copy to a forum topic. Forum supergroups only.
+func WithCopyMessageThreadID(messageThreadID int) CopyMessageOption {
+	return func(p *CopyMessageParams) {
+		p.MessageThreadID = messageThreadID
+	}
 }
 
 // Additional methods for advanced features would be implemented here,
 // such as:
-// - User and chat management
 // - Poll creation
 // - Payment methods
 // - Game methods
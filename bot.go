@@ -31,13 +31,33 @@ type Bot struct {
 	Debug       bool
 	Buffer      int
 	
+	// logger receives the bot's logs, see WithLogger
+	logger      Logger
+	
+	// metrics receives the bot's measurements, see WithMetrics
+	metrics     Metrics
+	
+	// tracer traces updates and API calls, see WithTracer
+	tracer      Tracer
+	
 	// mu protects the following fields
 	mu           sync.RWMutex
 	updateHandler UpdateHandler
+	chatMemberHooks []chatMemberHook
+	joinRequestHooks []ChatJoinRequestHandler
+	callbackRoutes  []callbackRoute
+	chatMigrationHandlers []ChatMigrationHandler
+	migratedChats   map[int64]int64
+	migrationOrder  []int64
+	middlewares     []APIMiddleware
 	
 	// Private fields
 	shutdownChan chan struct{}
 	retryCount   int
+	autoMigrate  bool
+
+	// sleep waits between retries; tests replace it to skip the wait
+	sleep func(ctx context.Context, d time.Duration) error
 }
 
 // BotOption is a function that configures a Bot.
@@ -71,6 +91,22 @@ func WithRetryCount(count int) BotOption {
 	}
 }
 
+// WithAPIEndpoint sets the base URL of the Bot API server, such as a local Bot
+// API server or a test server. The token is appended to it, as to APIBaseURL.
+func WithAPIEndpoint(baseURL string) BotOption {
+	return func(b *Bot) {
+		b.APIEndpoint = baseURL + b.Token
+	}
+}
+
+// WithAutoMigrate enables resending a request once to the new chat when it
+// fails because the group was migrated to a supergroup.
+func WithAutoMigrate(enabled bool) BotOption {
+	return func(b *Bot) {
+		b.autoMigrate = enabled
+	}
+}
+
 // New creates a new Bot instance.
 func New(token string, options ...BotOption) (*Bot, error) {
 	if token == "" {
@@ -84,6 +120,7 @@ func New(token string, options ...BotOption) (*Bot, error) {
 		shutdownChan: make(chan struct{}),
 		retryCount:   DefaultRetryCount,
 		Buffer:       100,
+		sleep:        sleepContext,
 	}
 
 	// Apply options
@@ -94,27 +131,29 @@ func New(token string, options ...BotOption) (*Bot, error) {
 	return bot, nil
 }
 
-// MakeRequest sends a request to the Telegram API.
+// MakeRequest sends a request to the Telegram API and returns the raw result.
 func (b *Bot) MakeRequest(ctx context.Context, endpoint string, params interface{}) ([]byte, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	
-	// Create request body
-	var reqBody []byte
-	var err error
-	
-	if params != nil {
-		reqBody, err = json.Marshal(params)
+	values, ok := params.(map[string]interface{})
+	if !ok && params != nil {
+		data, err := json.Marshal(params)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to marshal request params")
 		}
+		if err := json.Unmarshal(data, &values); err != nil {
+			return nil, errors.Wrap(err, "request params must be a JSON object")
+		}
 	}
 	
-	// TODO: Implement the actual HTTP request with retry logic
-	// This is a simplified version
+	var result json.RawMessage
+	if err := b.makeRequest(ctx, endpoint, values, &result); err != nil {
+		return nil, err
+	}
 	
-	return nil, nil
+	return result, nil
 }
 
 // Stop stops the bot's update polling.
@@ -122,9 +161,9 @@ func (b *Bot) Stop() {
 	close(b.shutdownChan)
 }
 
-// Debug prints debugging information if debug mode is enabled.
+// Debug logs debugging information if debug mode is enabled or a logger is set.
 func (b *Bot) debug(format string, a ...interface{}) {
-	if b.Debug {
-		fmt.Printf("[DEBUG] "+format+"\n", a...)
+	if b.logEnabled(LogLevelDebug) {
+		b.log(LogLevelDebug, fmt.Sprintf(format, a...))
 	}
 }
\ No newline at end of file
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -98,7 +99,7 @@ func TestWebhookHandler(t *testing.T) {
 	// Test with valid update
 	updateJSON := `{"update_id":123456,"message":{"message_id":1,"from":{"id":123,"first_name":"Test","is_bot":false},"chat":{"id":123,"first_name":"Test","type":"private"},"date":1600000000,"text":"Hello, world!"}}`
 	
-	resp, err := http.Post(server.URL, "application/json", []byte(updateJSON))
+	resp, err := http.Post(server.URL, "application/json", strings.NewReader(updateJSON))
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 	assert.NotNil(t, receivedUpdate)
@@ -112,7 +113,7 @@ func TestWebhookHandler(t *testing.T) {
 	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
 	
 	// Test with invalid JSON
-	resp, err = http.Post(server.URL, "application/json", []byte(`{"update_id":123456`))
+	resp, err = http.Post(server.URL, "application/json", strings.NewReader(`{"update_id":123456`))
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
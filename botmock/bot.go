@@ -0,0 +1,762 @@
+// Code generated by mockgen from interfaces.go. DO NOT EDIT.
+
+package botmock
+
+import (
+	"context"
+	"sync"
+
+	"github.com/KazeDevID/gotelegrambot"
+)
+
+// Bot is a fake gotelegrambot.API.
+//
+// Each method calls the field named after it with a Func suffix, or returns
+// an *UnexpectedCallError when the field is nil. Calls are recorded either way.
+type Bot struct {
+	SendMessageFunc                       func(ctx context.Context, chatID gotelegrambot.ChatID, text string, options ...gotelegrambot.SendMessageOption) (*gotelegrambot.Message, error)
+	SendLongMessageFunc                   func(ctx context.Context, chatID gotelegrambot.ChatID, text string, options ...gotelegrambot.SendMessageOption) ([]*gotelegrambot.Message, error)
+	SendPhotoFunc                         func(ctx context.Context, chatID gotelegrambot.ChatID, photo interface{}, options ...gotelegrambot.SendPhotoOption) (*gotelegrambot.Message, error)
+	SendDocumentFunc                      func(ctx context.Context, params gotelegrambot.SendDocumentParams) (*gotelegrambot.Message, error)
+	SendVideoFunc                         func(ctx context.Context, params gotelegrambot.SendVideoParams) (*gotelegrambot.Message, error)
+	SendAudioFunc                         func(ctx context.Context, params gotelegrambot.SendAudioParams) (*gotelegrambot.Message, error)
+	SendAnimationFunc                     func(ctx context.Context, params gotelegrambot.SendAnimationParams) (*gotelegrambot.Message, error)
+	SendVoiceFunc                         func(ctx context.Context, params gotelegrambot.SendVoiceParams) (*gotelegrambot.Message, error)
+	SendLocationFunc                      func(ctx context.Context, params gotelegrambot.SendLocationParams) (*gotelegrambot.Message, error)
+	SendVenueFunc                         func(ctx context.Context, params gotelegrambot.SendVenueParams) (*gotelegrambot.Message, error)
+	SendContactFunc                       func(ctx context.Context, params gotelegrambot.SendContactParams) (*gotelegrambot.Message, error)
+	SendDiceFunc                          func(ctx context.Context, params gotelegrambot.SendDiceParams) (*gotelegrambot.Message, error)
+	SendPollFunc                          func(ctx context.Context, chatID gotelegrambot.ChatID, question string, options []string, pollOptions ...gotelegrambot.SendPollOption) (*gotelegrambot.Message, error)
+	SendInvoiceFunc                       func(ctx context.Context, chatID gotelegrambot.ChatID, title string, description string, payload string, providerToken string, currency string, prices []gotelegrambot.LabeledPrice, options ...gotelegrambot.SendInvoiceOption) (*gotelegrambot.Message, error)
+	SendChatActionFunc                    func(ctx context.Context, chatID gotelegrambot.ChatID, action string, options ...gotelegrambot.ChatActionOption) error
+	ForwardMessageFunc                    func(ctx context.Context, chatID gotelegrambot.ChatID, fromChatID gotelegrambot.ChatID, messageID int, options ...gotelegrambot.ForwardMessageOption) (*gotelegrambot.Message, error)
+	CopyMessageFunc                       func(ctx context.Context, chatID gotelegrambot.ChatID, fromChatID gotelegrambot.ChatID, messageID int, options ...gotelegrambot.CopyMessageOption) (*gotelegrambot.MessageID, error)
+	EditMessageTextFunc                   func(ctx context.Context, options ...gotelegrambot.EditMessageTextOption) (*gotelegrambot.Message, error)
+	EditMessageCaptionFunc                func(ctx context.Context, params gotelegrambot.EditMessageCaptionParams) (*gotelegrambot.Message, error)
+	EditMessageReplyMarkupFunc            func(ctx context.Context, params gotelegrambot.EditMessageReplyMarkupParams) (*gotelegrambot.Message, error)
+	DeleteMessageFunc                     func(ctx context.Context, chatID gotelegrambot.ChatID, messageID int) error
+	StopPollFunc                          func(ctx context.Context, chatID gotelegrambot.ChatID, messageID int, replyMarkup *gotelegrambot.InlineKeyboardMarkup) (*gotelegrambot.Poll, error)
+	AnswerCallbackQueryFunc               func(ctx context.Context, callbackQueryID string, options ...gotelegrambot.AnswerCallbackQueryOption) error
+	AnswerInlineQueryFunc                 func(ctx context.Context, inlineQueryID string, results []gotelegrambot.InlineQueryResult, options ...gotelegrambot.AnswerInlineQueryOption) error
+	AnswerShippingQueryFunc               func(ctx context.Context, shippingQueryID string, ok bool, options ...gotelegrambot.AnswerShippingQueryOption) error
+	AnswerPreCheckoutQueryFunc            func(ctx context.Context, preCheckoutQueryID string, ok bool, errorMessage string) error
+	GetChatFunc                           func(ctx context.Context, chatID gotelegrambot.ChatID) (*gotelegrambot.ChatFullInfo, error)
+	GetChatAdministratorsFunc             func(ctx context.Context, chatID gotelegrambot.ChatID) ([]gotelegrambot.ChatMember, error)
+	GetChatMemberFunc                     func(ctx context.Context, chatID gotelegrambot.ChatID, userID int64) (*gotelegrambot.ChatMember, error)
+	GetChatMemberCountFunc                func(ctx context.Context, chatID gotelegrambot.ChatID) (int, error)
+	GetUserProfilePhotosFunc              func(ctx context.Context, params gotelegrambot.GetUserProfilePhotosParams) (*gotelegrambot.UserProfilePhotos, error)
+	BanChatMemberFunc                     func(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, options ...gotelegrambot.BanChatMemberOption) error
+	UnbanChatMemberFunc                   func(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, onlyIfBanned bool) error
+	RestrictChatMemberFunc                func(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, permissions gotelegrambot.ChatPermissions, options ...gotelegrambot.RestrictChatMemberOption) error
+	PromoteChatMemberFunc                 func(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, rights gotelegrambot.ChatAdministratorRights) error
+	SetChatAdministratorCustomTitleFunc   func(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, customTitle string) error
+	SetChatPermissionsFunc                func(ctx context.Context, chatID gotelegrambot.ChatID, permissions gotelegrambot.ChatPermissions, useIndependentChatPermissions bool) error
+	SetChatTitleFunc                      func(ctx context.Context, chatID gotelegrambot.ChatID, title string) error
+	SetChatDescriptionFunc                func(ctx context.Context, chatID gotelegrambot.ChatID, description string) error
+	SetChatPhotoFunc                      func(ctx context.Context, chatID gotelegrambot.ChatID, photoPath string) error
+	DeleteChatPhotoFunc                   func(ctx context.Context, chatID gotelegrambot.ChatID) error
+	PinChatMessageFunc                    func(ctx context.Context, chatID gotelegrambot.ChatID, messageID int, disableNotification bool) error
+	UnpinChatMessageFunc                  func(ctx context.Context, chatID gotelegrambot.ChatID, messageID int) error
+	UnpinAllChatMessagesFunc              func(ctx context.Context, chatID gotelegrambot.ChatID) error
+	LeaveChatFunc                         func(ctx context.Context, chatID gotelegrambot.ChatID) error
+	CreateChatInviteLinkFunc              func(ctx context.Context, chatID gotelegrambot.ChatID, options ...gotelegrambot.ChatInviteLinkOption) (*gotelegrambot.ChatInviteLink, error)
+	EditChatInviteLinkFunc                func(ctx context.Context, chatID gotelegrambot.ChatID, inviteLink string, options ...gotelegrambot.ChatInviteLinkOption) (*gotelegrambot.ChatInviteLink, error)
+	RevokeChatInviteLinkFunc              func(ctx context.Context, chatID gotelegrambot.ChatID, inviteLink string) (*gotelegrambot.ChatInviteLink, error)
+	ExportChatInviteLinkFunc              func(ctx context.Context, chatID gotelegrambot.ChatID) (string, error)
+	ApproveChatJoinRequestFunc            func(ctx context.Context, chatID gotelegrambot.ChatID, userID int64) error
+	DeclineChatJoinRequestFunc            func(ctx context.Context, chatID gotelegrambot.ChatID, userID int64) error
+	CreateForumTopicFunc                  func(ctx context.Context, chatID gotelegrambot.ChatID, name string, options ...gotelegrambot.CreateForumTopicOption) (*gotelegrambot.ForumTopic, error)
+	EditForumTopicFunc                    func(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int, options ...gotelegrambot.EditForumTopicOption) error
+	CloseForumTopicFunc                   func(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int) error
+	ReopenForumTopicFunc                  func(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int) error
+	DeleteForumTopicFunc                  func(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int) error
+	UnpinAllForumTopicMessagesFunc        func(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int) error
+	EditGeneralForumTopicFunc             func(ctx context.Context, chatID gotelegrambot.ChatID, name string) error
+	CloseGeneralForumTopicFunc            func(ctx context.Context, chatID gotelegrambot.ChatID) error
+	ReopenGeneralForumTopicFunc           func(ctx context.Context, chatID gotelegrambot.ChatID) error
+	HideGeneralForumTopicFunc             func(ctx context.Context, chatID gotelegrambot.ChatID) error
+	UnhideGeneralForumTopicFunc           func(ctx context.Context, chatID gotelegrambot.ChatID) error
+	UnpinAllGeneralForumTopicMessagesFunc func(ctx context.Context, chatID gotelegrambot.ChatID) error
+	GetForumTopicIconStickersFunc         func(ctx context.Context) ([]gotelegrambot.Sticker, error)
+	GetFileFunc                           func(ctx context.Context, fileID string) (*gotelegrambot.File, error)
+	DownloadFileFunc                      func(ctx context.Context, file *gotelegrambot.File, destPath string) error
+	GetChatPhotoFileFunc                  func(ctx context.Context, chatID gotelegrambot.ChatID, big bool) (*gotelegrambot.File, error)
+	DownloadChatPhotoFunc                 func(ctx context.Context, chatID gotelegrambot.ChatID, big bool, destPath string) error
+	GetMeFunc                             func(ctx context.Context) (*gotelegrambot.User, error)
+	GetMyNameFunc                         func(ctx context.Context, params gotelegrambot.GetMyNameParams) (*gotelegrambot.BotName, error)
+	SetMyNameFunc                         func(ctx context.Context, params gotelegrambot.SetMyNameParams) error
+	SetWebhookFunc                        func(ctx context.Context, config gotelegrambot.WebhookConfig) error
+	DeleteWebhookFunc                     func(ctx context.Context, dropPendingUpdates bool) error
+	GetWebhookInfoFunc                    func(ctx context.Context) (*gotelegrambot.WebhookInfo, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+var _ gotelegrambot.API = (*Bot)(nil)
+
+// SendMessage calls SendMessageFunc.
+func (m *Bot) SendMessage(ctx context.Context, chatID gotelegrambot.ChatID, text string, options ...gotelegrambot.SendMessageOption) (*gotelegrambot.Message, error) {
+	m.record("SendMessage", chatID, text, options)
+	if m.SendMessageFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendMessage"}
+	}
+	return m.SendMessageFunc(ctx, chatID, text, options...)
+}
+
+// SendLongMessage calls SendLongMessageFunc.
+func (m *Bot) SendLongMessage(ctx context.Context, chatID gotelegrambot.ChatID, text string, options ...gotelegrambot.SendMessageOption) ([]*gotelegrambot.Message, error) {
+	m.record("SendLongMessage", chatID, text, options)
+	if m.SendLongMessageFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendLongMessage"}
+	}
+	return m.SendLongMessageFunc(ctx, chatID, text, options...)
+}
+
+// SendPhoto calls SendPhotoFunc.
+func (m *Bot) SendPhoto(ctx context.Context, chatID gotelegrambot.ChatID, photo interface{}, options ...gotelegrambot.SendPhotoOption) (*gotelegrambot.Message, error) {
+	m.record("SendPhoto", chatID, photo, options)
+	if m.SendPhotoFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendPhoto"}
+	}
+	return m.SendPhotoFunc(ctx, chatID, photo, options...)
+}
+
+// SendDocument calls SendDocumentFunc.
+func (m *Bot) SendDocument(ctx context.Context, params gotelegrambot.SendDocumentParams) (*gotelegrambot.Message, error) {
+	m.record("SendDocument", params)
+	if m.SendDocumentFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendDocument"}
+	}
+	return m.SendDocumentFunc(ctx, params)
+}
+
+// SendVideo calls SendVideoFunc.
+func (m *Bot) SendVideo(ctx context.Context, params gotelegrambot.SendVideoParams) (*gotelegrambot.Message, error) {
+	m.record("SendVideo", params)
+	if m.SendVideoFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendVideo"}
+	}
+	return m.SendVideoFunc(ctx, params)
+}
+
+// SendAudio calls SendAudioFunc.
+func (m *Bot) SendAudio(ctx context.Context, params gotelegrambot.SendAudioParams) (*gotelegrambot.Message, error) {
+	m.record("SendAudio", params)
+	if m.SendAudioFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendAudio"}
+	}
+	return m.SendAudioFunc(ctx, params)
+}
+
+// SendAnimation calls SendAnimationFunc.
+func (m *Bot) SendAnimation(ctx context.Context, params gotelegrambot.SendAnimationParams) (*gotelegrambot.Message, error) {
+	m.record("SendAnimation", params)
+	if m.SendAnimationFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendAnimation"}
+	}
+	return m.SendAnimationFunc(ctx, params)
+}
+
+// SendVoice calls SendVoiceFunc.
+func (m *Bot) SendVoice(ctx context.Context, params gotelegrambot.SendVoiceParams) (*gotelegrambot.Message, error) {
+	m.record("SendVoice", params)
+	if m.SendVoiceFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendVoice"}
+	}
+	return m.SendVoiceFunc(ctx, params)
+}
+
+// SendLocation calls SendLocationFunc.
+func (m *Bot) SendLocation(ctx context.Context, params gotelegrambot.SendLocationParams) (*gotelegrambot.Message, error) {
+	m.record("SendLocation", params)
+	if m.SendLocationFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendLocation"}
+	}
+	return m.SendLocationFunc(ctx, params)
+}
+
+// SendVenue calls SendVenueFunc.
+func (m *Bot) SendVenue(ctx context.Context, params gotelegrambot.SendVenueParams) (*gotelegrambot.Message, error) {
+	m.record("SendVenue", params)
+	if m.SendVenueFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendVenue"}
+	}
+	return m.SendVenueFunc(ctx, params)
+}
+
+// SendContact calls SendContactFunc.
+func (m *Bot) SendContact(ctx context.Context, params gotelegrambot.SendContactParams) (*gotelegrambot.Message, error) {
+	m.record("SendContact", params)
+	if m.SendContactFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendContact"}
+	}
+	return m.SendContactFunc(ctx, params)
+}
+
+// SendDice calls SendDiceFunc.
+func (m *Bot) SendDice(ctx context.Context, params gotelegrambot.SendDiceParams) (*gotelegrambot.Message, error) {
+	m.record("SendDice", params)
+	if m.SendDiceFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendDice"}
+	}
+	return m.SendDiceFunc(ctx, params)
+}
+
+// SendPoll calls SendPollFunc.
+func (m *Bot) SendPoll(ctx context.Context, chatID gotelegrambot.ChatID, question string, options []string, pollOptions ...gotelegrambot.SendPollOption) (*gotelegrambot.Message, error) {
+	m.record("SendPoll", chatID, question, options, pollOptions)
+	if m.SendPollFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendPoll"}
+	}
+	return m.SendPollFunc(ctx, chatID, question, options, pollOptions...)
+}
+
+// SendInvoice calls SendInvoiceFunc.
+func (m *Bot) SendInvoice(ctx context.Context, chatID gotelegrambot.ChatID, title string, description string, payload string, providerToken string, currency string, prices []gotelegrambot.LabeledPrice, options ...gotelegrambot.SendInvoiceOption) (*gotelegrambot.Message, error) {
+	m.record("SendInvoice", chatID, title, description, payload, providerToken, currency, prices, options)
+	if m.SendInvoiceFunc == nil {
+		return nil, &UnexpectedCallError{Method: "SendInvoice"}
+	}
+	return m.SendInvoiceFunc(ctx, chatID, title, description, payload, providerToken, currency, prices, options...)
+}
+
+// SendChatAction calls SendChatActionFunc.
+func (m *Bot) SendChatAction(ctx context.Context, chatID gotelegrambot.ChatID, action string, options ...gotelegrambot.ChatActionOption) error {
+	m.record("SendChatAction", chatID, action, options)
+	if m.SendChatActionFunc == nil {
+		return &UnexpectedCallError{Method: "SendChatAction"}
+	}
+	return m.SendChatActionFunc(ctx, chatID, action, options...)
+}
+
+// ForwardMessage calls ForwardMessageFunc.
+func (m *Bot) ForwardMessage(ctx context.Context, chatID gotelegrambot.ChatID, fromChatID gotelegrambot.ChatID, messageID int, options ...gotelegrambot.ForwardMessageOption) (*gotelegrambot.Message, error) {
+	m.record("ForwardMessage", chatID, fromChatID, messageID, options)
+	if m.ForwardMessageFunc == nil {
+		return nil, &UnexpectedCallError{Method: "ForwardMessage"}
+	}
+	return m.ForwardMessageFunc(ctx, chatID, fromChatID, messageID, options...)
+}
+
+// CopyMessage calls CopyMessageFunc.
+func (m *Bot) CopyMessage(ctx context.Context, chatID gotelegrambot.ChatID, fromChatID gotelegrambot.ChatID, messageID int, options ...gotelegrambot.CopyMessageOption) (*gotelegrambot.MessageID, error) {
+	m.record("CopyMessage", chatID, fromChatID, messageID, options)
+	if m.CopyMessageFunc == nil {
+		return nil, &UnexpectedCallError{Method: "CopyMessage"}
+	}
+	return m.CopyMessageFunc(ctx, chatID, fromChatID, messageID, options...)
+}
+
+// EditMessageText calls EditMessageTextFunc.
+func (m *Bot) EditMessageText(ctx context.Context, options ...gotelegrambot.EditMessageTextOption) (*gotelegrambot.Message, error) {
+	m.record("EditMessageText", options)
+	if m.EditMessageTextFunc == nil {
+		return nil, &UnexpectedCallError{Method: "EditMessageText"}
+	}
+	return m.EditMessageTextFunc(ctx, options...)
+}
+
+// EditMessageCaption calls EditMessageCaptionFunc.
+func (m *Bot) EditMessageCaption(ctx context.Context, params gotelegrambot.EditMessageCaptionParams) (*gotelegrambot.Message, error) {
+	m.record("EditMessageCaption", params)
+	if m.EditMessageCaptionFunc == nil {
+		return nil, &UnexpectedCallError{Method: "EditMessageCaption"}
+	}
+	return m.EditMessageCaptionFunc(ctx, params)
+}
+
+// EditMessageReplyMarkup calls EditMessageReplyMarkupFunc.
+func (m *Bot) EditMessageReplyMarkup(ctx context.Context, params gotelegrambot.EditMessageReplyMarkupParams) (*gotelegrambot.Message, error) {
+	m.record("EditMessageReplyMarkup", params)
+	if m.EditMessageReplyMarkupFunc == nil {
+		return nil, &UnexpectedCallError{Method: "EditMessageReplyMarkup"}
+	}
+	return m.EditMessageReplyMarkupFunc(ctx, params)
+}
+
+// DeleteMessage calls DeleteMessageFunc.
+func (m *Bot) DeleteMessage(ctx context.Context, chatID gotelegrambot.ChatID, messageID int) error {
+	m.record("DeleteMessage", chatID, messageID)
+	if m.DeleteMessageFunc == nil {
+		return &UnexpectedCallError{Method: "DeleteMessage"}
+	}
+	return m.DeleteMessageFunc(ctx, chatID, messageID)
+}
+
+// StopPoll calls StopPollFunc.
+func (m *Bot) StopPoll(ctx context.Context, chatID gotelegrambot.ChatID, messageID int, replyMarkup *gotelegrambot.InlineKeyboardMarkup) (*gotelegrambot.Poll, error) {
+	m.record("StopPoll", chatID, messageID, replyMarkup)
+	if m.StopPollFunc == nil {
+		return nil, &UnexpectedCallError{Method: "StopPoll"}
+	}
+	return m.StopPollFunc(ctx, chatID, messageID, replyMarkup)
+}
+
+// AnswerCallbackQuery calls AnswerCallbackQueryFunc.
+func (m *Bot) AnswerCallbackQuery(ctx context.Context, callbackQueryID string, options ...gotelegrambot.AnswerCallbackQueryOption) error {
+	m.record("AnswerCallbackQuery", callbackQueryID, options)
+	if m.AnswerCallbackQueryFunc == nil {
+		return &UnexpectedCallError{Method: "AnswerCallbackQuery"}
+	}
+	return m.AnswerCallbackQueryFunc(ctx, callbackQueryID, options...)
+}
+
+// AnswerInlineQuery calls AnswerInlineQueryFunc.
+func (m *Bot) AnswerInlineQuery(ctx context.Context, inlineQueryID string, results []gotelegrambot.InlineQueryResult, options ...gotelegrambot.AnswerInlineQueryOption) error {
+	m.record("AnswerInlineQuery", inlineQueryID, results, options)
+	if m.AnswerInlineQueryFunc == nil {
+		return &UnexpectedCallError{Method: "AnswerInlineQuery"}
+	}
+	return m.AnswerInlineQueryFunc(ctx, inlineQueryID, results, options...)
+}
+
+// AnswerShippingQuery calls AnswerShippingQueryFunc.
+func (m *Bot) AnswerShippingQuery(ctx context.Context, shippingQueryID string, ok bool, options ...gotelegrambot.AnswerShippingQueryOption) error {
+	m.record("AnswerShippingQuery", shippingQueryID, ok, options)
+	if m.AnswerShippingQueryFunc == nil {
+		return &UnexpectedCallError{Method: "AnswerShippingQuery"}
+	}
+	return m.AnswerShippingQueryFunc(ctx, shippingQueryID, ok, options...)
+}
+
+// AnswerPreCheckoutQuery calls AnswerPreCheckoutQueryFunc.
+func (m *Bot) AnswerPreCheckoutQuery(ctx context.Context, preCheckoutQueryID string, ok bool, errorMessage string) error {
+	m.record("AnswerPreCheckoutQuery", preCheckoutQueryID, ok, errorMessage)
+	if m.AnswerPreCheckoutQueryFunc == nil {
+		return &UnexpectedCallError{Method: "AnswerPreCheckoutQuery"}
+	}
+	return m.AnswerPreCheckoutQueryFunc(ctx, preCheckoutQueryID, ok, errorMessage)
+}
+
+// GetChat calls GetChatFunc.
+func (m *Bot) GetChat(ctx context.Context, chatID gotelegrambot.ChatID) (*gotelegrambot.ChatFullInfo, error) {
+	m.record("GetChat", chatID)
+	if m.GetChatFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetChat"}
+	}
+	return m.GetChatFunc(ctx, chatID)
+}
+
+// GetChatAdministrators calls GetChatAdministratorsFunc.
+func (m *Bot) GetChatAdministrators(ctx context.Context, chatID gotelegrambot.ChatID) ([]gotelegrambot.ChatMember, error) {
+	m.record("GetChatAdministrators", chatID)
+	if m.GetChatAdministratorsFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetChatAdministrators"}
+	}
+	return m.GetChatAdministratorsFunc(ctx, chatID)
+}
+
+// GetChatMember calls GetChatMemberFunc.
+func (m *Bot) GetChatMember(ctx context.Context, chatID gotelegrambot.ChatID, userID int64) (*gotelegrambot.ChatMember, error) {
+	m.record("GetChatMember", chatID, userID)
+	if m.GetChatMemberFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetChatMember"}
+	}
+	return m.GetChatMemberFunc(ctx, chatID, userID)
+}
+
+// GetChatMemberCount calls GetChatMemberCountFunc.
+func (m *Bot) GetChatMemberCount(ctx context.Context, chatID gotelegrambot.ChatID) (int, error) {
+	m.record("GetChatMemberCount", chatID)
+	if m.GetChatMemberCountFunc == nil {
+		return 0, &UnexpectedCallError{Method: "GetChatMemberCount"}
+	}
+	return m.GetChatMemberCountFunc(ctx, chatID)
+}
+
+// GetUserProfilePhotos calls GetUserProfilePhotosFunc.
+func (m *Bot) GetUserProfilePhotos(ctx context.Context, params gotelegrambot.GetUserProfilePhotosParams) (*gotelegrambot.UserProfilePhotos, error) {
+	m.record("GetUserProfilePhotos", params)
+	if m.GetUserProfilePhotosFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetUserProfilePhotos"}
+	}
+	return m.GetUserProfilePhotosFunc(ctx, params)
+}
+
+// BanChatMember calls BanChatMemberFunc.
+func (m *Bot) BanChatMember(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, options ...gotelegrambot.BanChatMemberOption) error {
+	m.record("BanChatMember", chatID, userID, options)
+	if m.BanChatMemberFunc == nil {
+		return &UnexpectedCallError{Method: "BanChatMember"}
+	}
+	return m.BanChatMemberFunc(ctx, chatID, userID, options...)
+}
+
+// UnbanChatMember calls UnbanChatMemberFunc.
+func (m *Bot) UnbanChatMember(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, onlyIfBanned bool) error {
+	m.record("UnbanChatMember", chatID, userID, onlyIfBanned)
+	if m.UnbanChatMemberFunc == nil {
+		return &UnexpectedCallError{Method: "UnbanChatMember"}
+	}
+	return m.UnbanChatMemberFunc(ctx, chatID, userID, onlyIfBanned)
+}
+
+// RestrictChatMember calls RestrictChatMemberFunc.
+func (m *Bot) RestrictChatMember(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, permissions gotelegrambot.ChatPermissions, options ...gotelegrambot.RestrictChatMemberOption) error {
+	m.record("RestrictChatMember", chatID, userID, permissions, options)
+	if m.RestrictChatMemberFunc == nil {
+		return &UnexpectedCallError{Method: "RestrictChatMember"}
+	}
+	return m.RestrictChatMemberFunc(ctx, chatID, userID, permissions, options...)
+}
+
+// PromoteChatMember calls PromoteChatMemberFunc.
+func (m *Bot) PromoteChatMember(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, rights gotelegrambot.ChatAdministratorRights) error {
+	m.record("PromoteChatMember", chatID, userID, rights)
+	if m.PromoteChatMemberFunc == nil {
+		return &UnexpectedCallError{Method: "PromoteChatMember"}
+	}
+	return m.PromoteChatMemberFunc(ctx, chatID, userID, rights)
+}
+
+// SetChatAdministratorCustomTitle calls SetChatAdministratorCustomTitleFunc.
+func (m *Bot) SetChatAdministratorCustomTitle(ctx context.Context, chatID gotelegrambot.ChatID, userID int64, customTitle string) error {
+	m.record("SetChatAdministratorCustomTitle", chatID, userID, customTitle)
+	if m.SetChatAdministratorCustomTitleFunc == nil {
+		return &UnexpectedCallError{Method: "SetChatAdministratorCustomTitle"}
+	}
+	return m.SetChatAdministratorCustomTitleFunc(ctx, chatID, userID, customTitle)
+}
+
+// SetChatPermissions calls SetChatPermissionsFunc.
+func (m *Bot) SetChatPermissions(ctx context.Context, chatID gotelegrambot.ChatID, permissions gotelegrambot.ChatPermissions, useIndependentChatPermissions bool) error {
+	m.record("SetChatPermissions", chatID, permissions, useIndependentChatPermissions)
+	if m.SetChatPermissionsFunc == nil {
+		return &UnexpectedCallError{Method: "SetChatPermissions"}
+	}
+	return m.SetChatPermissionsFunc(ctx, chatID, permissions, useIndependentChatPermissions)
+}
+
+// SetChatTitle calls SetChatTitleFunc.
+func (m *Bot) SetChatTitle(ctx context.Context, chatID gotelegrambot.ChatID, title string) error {
+	m.record("SetChatTitle", chatID, title)
+	if m.SetChatTitleFunc == nil {
+		return &UnexpectedCallError{Method: "SetChatTitle"}
+	}
+	return m.SetChatTitleFunc(ctx, chatID, title)
+}
+
+// SetChatDescription calls SetChatDescriptionFunc.
+func (m *Bot) SetChatDescription(ctx context.Context, chatID gotelegrambot.ChatID, description string) error {
+	m.record("SetChatDescription", chatID, description)
+	if m.SetChatDescriptionFunc == nil {
+		return &UnexpectedCallError{Method: "SetChatDescription"}
+	}
+	return m.SetChatDescriptionFunc(ctx, chatID, description)
+}
+
+// SetChatPhoto calls SetChatPhotoFunc.
+func (m *Bot) SetChatPhoto(ctx context.Context, chatID gotelegrambot.ChatID, photoPath string) error {
+	m.record("SetChatPhoto", chatID, photoPath)
+	if m.SetChatPhotoFunc == nil {
+		return &UnexpectedCallError{Method: "SetChatPhoto"}
+	}
+	return m.SetChatPhotoFunc(ctx, chatID, photoPath)
+}
+
+// DeleteChatPhoto calls DeleteChatPhotoFunc.
+func (m *Bot) DeleteChatPhoto(ctx context.Context, chatID gotelegrambot.ChatID) error {
+	m.record("DeleteChatPhoto", chatID)
+	if m.DeleteChatPhotoFunc == nil {
+		return &UnexpectedCallError{Method: "DeleteChatPhoto"}
+	}
+	return m.DeleteChatPhotoFunc(ctx, chatID)
+}
+
+// PinChatMessage calls PinChatMessageFunc.
+func (m *Bot) PinChatMessage(ctx context.Context, chatID gotelegrambot.ChatID, messageID int, disableNotification bool) error {
+	m.record("PinChatMessage", chatID, messageID, disableNotification)
+	if m.PinChatMessageFunc == nil {
+		return &UnexpectedCallError{Method: "PinChatMessage"}
+	}
+	return m.PinChatMessageFunc(ctx, chatID, messageID, disableNotification)
+}
+
+// UnpinChatMessage calls UnpinChatMessageFunc.
+func (m *Bot) UnpinChatMessage(ctx context.Context, chatID gotelegrambot.ChatID, messageID int) error {
+	m.record("UnpinChatMessage", chatID, messageID)
+	if m.UnpinChatMessageFunc == nil {
+		return &UnexpectedCallError{Method: "UnpinChatMessage"}
+	}
+	return m.UnpinChatMessageFunc(ctx, chatID, messageID)
+}
+
+// UnpinAllChatMessages calls UnpinAllChatMessagesFunc.
+func (m *Bot) UnpinAllChatMessages(ctx context.Context, chatID gotelegrambot.ChatID) error {
+	m.record("UnpinAllChatMessages", chatID)
+	if m.UnpinAllChatMessagesFunc == nil {
+		return &UnexpectedCallError{Method: "UnpinAllChatMessages"}
+	}
+	return m.UnpinAllChatMessagesFunc(ctx, chatID)
+}
+
+// LeaveChat calls LeaveChatFunc.
+func (m *Bot) LeaveChat(ctx context.Context, chatID gotelegrambot.ChatID) error {
+	m.record("LeaveChat", chatID)
+	if m.LeaveChatFunc == nil {
+		return &UnexpectedCallError{Method: "LeaveChat"}
+	}
+	return m.LeaveChatFunc(ctx, chatID)
+}
+
+// CreateChatInviteLink calls CreateChatInviteLinkFunc.
+func (m *Bot) CreateChatInviteLink(ctx context.Context, chatID gotelegrambot.ChatID, options ...gotelegrambot.ChatInviteLinkOption) (*gotelegrambot.ChatInviteLink, error) {
+	m.record("CreateChatInviteLink", chatID, options)
+	if m.CreateChatInviteLinkFunc == nil {
+		return nil, &UnexpectedCallError{Method: "CreateChatInviteLink"}
+	}
+	return m.CreateChatInviteLinkFunc(ctx, chatID, options...)
+}
+
+// EditChatInviteLink calls EditChatInviteLinkFunc.
+func (m *Bot) EditChatInviteLink(ctx context.Context, chatID gotelegrambot.ChatID, inviteLink string, options ...gotelegrambot.ChatInviteLinkOption) (*gotelegrambot.ChatInviteLink, error) {
+	m.record("EditChatInviteLink", chatID, inviteLink, options)
+	if m.EditChatInviteLinkFunc == nil {
+		return nil, &UnexpectedCallError{Method: "EditChatInviteLink"}
+	}
+	return m.EditChatInviteLinkFunc(ctx, chatID, inviteLink, options...)
+}
+
+// RevokeChatInviteLink calls RevokeChatInviteLinkFunc.
+func (m *Bot) RevokeChatInviteLink(ctx context.Context, chatID gotelegrambot.ChatID, inviteLink string) (*gotelegrambot.ChatInviteLink, error) {
+	m.record("RevokeChatInviteLink", chatID, inviteLink)
+	if m.RevokeChatInviteLinkFunc == nil {
+		return nil, &UnexpectedCallError{Method: "RevokeChatInviteLink"}
+	}
+	return m.RevokeChatInviteLinkFunc(ctx, chatID, inviteLink)
+}
+
+// ExportChatInviteLink calls ExportChatInviteLinkFunc.
+func (m *Bot) ExportChatInviteLink(ctx context.Context, chatID gotelegrambot.ChatID) (string, error) {
+	m.record("ExportChatInviteLink", chatID)
+	if m.ExportChatInviteLinkFunc == nil {
+		return "", &UnexpectedCallError{Method: "ExportChatInviteLink"}
+	}
+	return m.ExportChatInviteLinkFunc(ctx, chatID)
+}
+
+// ApproveChatJoinRequest calls ApproveChatJoinRequestFunc.
+func (m *Bot) ApproveChatJoinRequest(ctx context.Context, chatID gotelegrambot.ChatID, userID int64) error {
+	m.record("ApproveChatJoinRequest", chatID, userID)
+	if m.ApproveChatJoinRequestFunc == nil {
+		return &UnexpectedCallError{Method: "ApproveChatJoinRequest"}
+	}
+	return m.ApproveChatJoinRequestFunc(ctx, chatID, userID)
+}
+
+// DeclineChatJoinRequest calls DeclineChatJoinRequestFunc.
+func (m *Bot) DeclineChatJoinRequest(ctx context.Context, chatID gotelegrambot.ChatID, userID int64) error {
+	m.record("DeclineChatJoinRequest", chatID, userID)
+	if m.DeclineChatJoinRequestFunc == nil {
+		return &UnexpectedCallError{Method: "DeclineChatJoinRequest"}
+	}
+	return m.DeclineChatJoinRequestFunc(ctx, chatID, userID)
+}
+
+// CreateForumTopic calls CreateForumTopicFunc.
+func (m *Bot) CreateForumTopic(ctx context.Context, chatID gotelegrambot.ChatID, name string, options ...gotelegrambot.CreateForumTopicOption) (*gotelegrambot.ForumTopic, error) {
+	m.record("CreateForumTopic", chatID, name, options)
+	if m.CreateForumTopicFunc == nil {
+		return nil, &UnexpectedCallError{Method: "CreateForumTopic"}
+	}
+	return m.CreateForumTopicFunc(ctx, chatID, name, options...)
+}
+
+// EditForumTopic calls EditForumTopicFunc.
+func (m *Bot) EditForumTopic(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int, options ...gotelegrambot.EditForumTopicOption) error {
+	m.record("EditForumTopic", chatID, messageThreadID, options)
+	if m.EditForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "EditForumTopic"}
+	}
+	return m.EditForumTopicFunc(ctx, chatID, messageThreadID, options...)
+}
+
+// CloseForumTopic calls CloseForumTopicFunc.
+func (m *Bot) CloseForumTopic(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int) error {
+	m.record("CloseForumTopic", chatID, messageThreadID)
+	if m.CloseForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "CloseForumTopic"}
+	}
+	return m.CloseForumTopicFunc(ctx, chatID, messageThreadID)
+}
+
+// ReopenForumTopic calls ReopenForumTopicFunc.
+func (m *Bot) ReopenForumTopic(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int) error {
+	m.record("ReopenForumTopic", chatID, messageThreadID)
+	if m.ReopenForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "ReopenForumTopic"}
+	}
+	return m.ReopenForumTopicFunc(ctx, chatID, messageThreadID)
+}
+
+// DeleteForumTopic calls DeleteForumTopicFunc.
+func (m *Bot) DeleteForumTopic(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int) error {
+	m.record("DeleteForumTopic", chatID, messageThreadID)
+	if m.DeleteForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "DeleteForumTopic"}
+	}
+	return m.DeleteForumTopicFunc(ctx, chatID, messageThreadID)
+}
+
+// UnpinAllForumTopicMessages calls UnpinAllForumTopicMessagesFunc.
+func (m *Bot) UnpinAllForumTopicMessages(ctx context.Context, chatID gotelegrambot.ChatID, messageThreadID int) error {
+	m.record("UnpinAllForumTopicMessages", chatID, messageThreadID)
+	if m.UnpinAllForumTopicMessagesFunc == nil {
+		return &UnexpectedCallError{Method: "UnpinAllForumTopicMessages"}
+	}
+	return m.UnpinAllForumTopicMessagesFunc(ctx, chatID, messageThreadID)
+}
+
+// EditGeneralForumTopic calls EditGeneralForumTopicFunc.
+func (m *Bot) EditGeneralForumTopic(ctx context.Context, chatID gotelegrambot.ChatID, name string) error {
+	m.record("EditGeneralForumTopic", chatID, name)
+	if m.EditGeneralForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "EditGeneralForumTopic"}
+	}
+	return m.EditGeneralForumTopicFunc(ctx, chatID, name)
+}
+
+// CloseGeneralForumTopic calls CloseGeneralForumTopicFunc.
+func (m *Bot) CloseGeneralForumTopic(ctx context.Context, chatID gotelegrambot.ChatID) error {
+	m.record("CloseGeneralForumTopic", chatID)
+	if m.CloseGeneralForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "CloseGeneralForumTopic"}
+	}
+	return m.CloseGeneralForumTopicFunc(ctx, chatID)
+}
+
+// ReopenGeneralForumTopic calls ReopenGeneralForumTopicFunc.
+func (m *Bot) ReopenGeneralForumTopic(ctx context.Context, chatID gotelegrambot.ChatID) error {
+	m.record("ReopenGeneralForumTopic", chatID)
+	if m.ReopenGeneralForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "ReopenGeneralForumTopic"}
+	}
+	return m.ReopenGeneralForumTopicFunc(ctx, chatID)
+}
+
+// HideGeneralForumTopic calls HideGeneralForumTopicFunc.
+func (m *Bot) HideGeneralForumTopic(ctx context.Context, chatID gotelegrambot.ChatID) error {
+	m.record("HideGeneralForumTopic", chatID)
+	if m.HideGeneralForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "HideGeneralForumTopic"}
+	}
+	return m.HideGeneralForumTopicFunc(ctx, chatID)
+}
+
+// UnhideGeneralForumTopic calls UnhideGeneralForumTopicFunc.
+func (m *Bot) UnhideGeneralForumTopic(ctx context.Context, chatID gotelegrambot.ChatID) error {
+	m.record("UnhideGeneralForumTopic", chatID)
+	if m.UnhideGeneralForumTopicFunc == nil {
+		return &UnexpectedCallError{Method: "UnhideGeneralForumTopic"}
+	}
+	return m.UnhideGeneralForumTopicFunc(ctx, chatID)
+}
+
+// UnpinAllGeneralForumTopicMessages calls UnpinAllGeneralForumTopicMessagesFunc.
+func (m *Bot) UnpinAllGeneralForumTopicMessages(ctx context.Context, chatID gotelegrambot.ChatID) error {
+	m.record("UnpinAllGeneralForumTopicMessages", chatID)
+	if m.UnpinAllGeneralForumTopicMessagesFunc == nil {
+		return &UnexpectedCallError{Method: "UnpinAllGeneralForumTopicMessages"}
+	}
+	return m.UnpinAllGeneralForumTopicMessagesFunc(ctx, chatID)
+}
+
+// GetForumTopicIconStickers calls GetForumTopicIconStickersFunc.
+func (m *Bot) GetForumTopicIconStickers(ctx context.Context) ([]gotelegrambot.Sticker, error) {
+	m.record("GetForumTopicIconStickers")
+	if m.GetForumTopicIconStickersFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetForumTopicIconStickers"}
+	}
+	return m.GetForumTopicIconStickersFunc(ctx)
+}
+
+// GetFile calls GetFileFunc.
+func (m *Bot) GetFile(ctx context.Context, fileID string) (*gotelegrambot.File, error) {
+	m.record("GetFile", fileID)
+	if m.GetFileFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetFile"}
+	}
+	return m.GetFileFunc(ctx, fileID)
+}
+
+// DownloadFile calls DownloadFileFunc.
+func (m *Bot) DownloadFile(ctx context.Context, file *gotelegrambot.File, destPath string) error {
+	m.record("DownloadFile", file, destPath)
+	if m.DownloadFileFunc == nil {
+		return &UnexpectedCallError{Method: "DownloadFile"}
+	}
+	return m.DownloadFileFunc(ctx, file, destPath)
+}
+
+// GetChatPhotoFile calls GetChatPhotoFileFunc.
+func (m *Bot) GetChatPhotoFile(ctx context.Context, chatID gotelegrambot.ChatID, big bool) (*gotelegrambot.File, error) {
+	m.record("GetChatPhotoFile", chatID, big)
+	if m.GetChatPhotoFileFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetChatPhotoFile"}
+	}
+	return m.GetChatPhotoFileFunc(ctx, chatID, big)
+}
+
+// DownloadChatPhoto calls DownloadChatPhotoFunc.
+func (m *Bot) DownloadChatPhoto(ctx context.Context, chatID gotelegrambot.ChatID, big bool, destPath string) error {
+	m.record("DownloadChatPhoto", chatID, big, destPath)
+	if m.DownloadChatPhotoFunc == nil {
+		return &UnexpectedCallError{Method: "DownloadChatPhoto"}
+	}
+	return m.DownloadChatPhotoFunc(ctx, chatID, big, destPath)
+}
+
+// GetMe calls GetMeFunc.
+func (m *Bot) GetMe(ctx context.Context) (*gotelegrambot.User, error) {
+	m.record("GetMe")
+	if m.GetMeFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetMe"}
+	}
+	return m.GetMeFunc(ctx)
+}
+
+// GetMyName calls GetMyNameFunc.
+func (m *Bot) GetMyName(ctx context.Context, params gotelegrambot.GetMyNameParams) (*gotelegrambot.BotName, error) {
+	m.record("GetMyName", params)
+	if m.GetMyNameFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetMyName"}
+	}
+	return m.GetMyNameFunc(ctx, params)
+}
+
+// SetMyName calls SetMyNameFunc.
+func (m *Bot) SetMyName(ctx context.Context, params gotelegrambot.SetMyNameParams) error {
+	m.record("SetMyName", params)
+	if m.SetMyNameFunc == nil {
+		return &UnexpectedCallError{Method: "SetMyName"}
+	}
+	return m.SetMyNameFunc(ctx, params)
+}
+
+// SetWebhook calls SetWebhookFunc.
+func (m *Bot) SetWebhook(ctx context.Context, config gotelegrambot.WebhookConfig) error {
+	m.record("SetWebhook", config)
+	if m.SetWebhookFunc == nil {
+		return &UnexpectedCallError{Method: "SetWebhook"}
+	}
+	return m.SetWebhookFunc(ctx, config)
+}
+
+// DeleteWebhook calls DeleteWebhookFunc.
+func (m *Bot) DeleteWebhook(ctx context.Context, dropPendingUpdates bool) error {
+	m.record("DeleteWebhook", dropPendingUpdates)
+	if m.DeleteWebhookFunc == nil {
+		return &UnexpectedCallError{Method: "DeleteWebhook"}
+	}
+	return m.DeleteWebhookFunc(ctx, dropPendingUpdates)
+}
+
+// GetWebhookInfo calls GetWebhookInfoFunc.
+func (m *Bot) GetWebhookInfo(ctx context.Context) (*gotelegrambot.WebhookInfo, error) {
+	m.record("GetWebhookInfo")
+	if m.GetWebhookInfoFunc == nil {
+		return nil, &UnexpectedCallError{Method: "GetWebhookInfo"}
+	}
+	return m.GetWebhookInfoFunc(ctx)
+}
@@ -0,0 +1,76 @@
+// Package botmock provides Bot, a fake of gotelegrambot.API for unit testing
+// code that depends on the bot's interfaces without going over the network:
+//
+//	bot := &botmock.Bot{
+//		SendMessageFunc: func(ctx context.Context, chatID gotelegrambot.ChatID, text string, options ...gotelegrambot.SendMessageOption) (*gotelegrambot.Message, error) {
+//			return &gotelegrambot.Message{MessageID: 1, Text: text}, nil
+//		},
+//	}
+//	notifier := NewNotifier(bot) // accepts a gotelegrambot.Sender
+//
+// Bot is generated from the interfaces by cmd/mockgen.
+package botmock
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Call is a recorded call. Args holds the arguments but the context, with
+// variadic options as a slice.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// UnexpectedCallError is returned by methods without a function set.
+type UnexpectedCallError struct {
+	Method string
+}
+
+// Error implements the error interface.
+func (e *UnexpectedCallError) Error() string {
+	return "botmock: unexpected call to " + e.Method
+}
+
+// IsUnexpectedCall reports whether err is, or wraps, an UnexpectedCallError.
+func IsUnexpectedCall(err error) bool {
+	var unexpected *UnexpectedCallError
+	return errors.As(err, &unexpected)
+}
+
+// Calls returns the calls made so far, in order.
+func (m *Bot) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the calls made so far to a method.
+func (m *Bot) CallsTo(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var calls []Call
+	for _, call := range m.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls made so far.
+func (m *Bot) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = nil
+}
+
+func (m *Bot) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
@@ -0,0 +1,40 @@
+package botmock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// greet is business logic depending on the smallest interface it needs.
+func greet(ctx context.Context, sender gotelegrambot.Sender, chatID gotelegrambot.ChatID, name string) error {
+	_, err := sender.SendMessage(ctx, chatID, "Hello, "+name, gotelegrambot.WithParseMode("HTML"))
+	return err
+}
+
+func TestBot(t *testing.T) {
+	bot := &Bot{
+		SendMessageFunc: func(ctx context.Context, chatID gotelegrambot.ChatID, text string, options ...gotelegrambot.SendMessageOption) (*gotelegrambot.Message, error) {
+			return &gotelegrambot.Message{MessageID: 1, Text: text}, nil
+		},
+	}
+
+	require.NoError(t, greet(context.Background(), bot, gotelegrambot.NewChatID(42), "Alice"))
+
+	calls := bot.CallsTo("SendMessage")
+	require.Len(t, calls, 1)
+	assert.Equal(t, gotelegrambot.NewChatID(42), calls[0].Args[0])
+	assert.Equal(t, "Hello, Alice", calls[0].Args[1])
+	assert.Len(t, calls[0].Args[2], 1)
+
+	err := bot.DeleteMessage(context.Background(), gotelegrambot.NewChatID(42), 1)
+	assert.True(t, IsUnexpectedCall(err))
+	assert.EqualError(t, err, "botmock: unexpected call to DeleteMessage")
+	assert.Len(t, bot.Calls(), 2)
+
+	bot.Reset()
+	assert.Empty(t, bot.Calls())
+}
@@ -0,0 +1,95 @@
+package gotelegrambot
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GetChat gets up to date information about a chat.
+func (b *Bot) GetChat(ctx context.Context, chatID ChatID) (*ChatFullInfo, error) {
+	return GetChatParams{ChatID: chatID}.do(ctx, b)
+}
+
+// SetChatTitle changes the title of a chat.
+func (b *Bot) SetChatTitle(ctx context.Context, chatID ChatID, title string) error {
+	return SetChatTitleParams{ChatID: chatID, Title: title}.do(ctx, b)
+}
+
+// SetChatDescription changes the description of a group, a supergroup or a channel.
+// An empty description removes it.
+func (b *Bot) SetChatDescription(ctx context.Context, chatID ChatID, description string) error {
+	return SetChatDescriptionParams{ChatID: chatID, Description: description}.do(ctx, b)
+}
+
+// SetChatPhoto uploads a new profile photo for the chat from a local file.
+func (b *Bot) SetChatPhoto(ctx context.Context, chatID ChatID, photoPath string) error {
+	params := SetChatPhotoParams{
+		ChatID: chatID,
+		Photo:  "file://" + strings.TrimPrefix(photoPath, "file://"),
+	}
+
+	return params.do(ctx, b)
+}
+
+// DeleteChatPhoto deletes the chat photo.
+func (b *Bot) DeleteChatPhoto(ctx context.Context, chatID ChatID) error {
+	return DeleteChatPhotoParams{ChatID: chatID}.do(ctx, b)
+}
+
+// GetChatPhotoFile gets the file of the chat photo, ready to be passed to DownloadFile.
+func (b *Bot) GetChatPhotoFile(ctx context.Context, chatID ChatID, big bool) (*File, error) {
+	chat, err := b.GetChat(ctx, chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if chat.Photo == nil {
+		return nil, errors.New("chat has no photo")
+	}
+
+	fileID := chat.Photo.SmallFileID
+	if big {
+		fileID = chat.Photo.BigFileID
+	}
+
+	return b.GetFile(ctx, fileID)
+}
+
+// DownloadChatPhoto downloads the chat photo to the specified path.
+func (b *Bot) DownloadChatPhoto(ctx context.Context, chatID ChatID, big bool, destPath string) error {
+	file, err := b.GetChatPhotoFile(ctx, chatID, big)
+	if err != nil {
+		return err
+	}
+
+	return b.DownloadFile(ctx, file, destPath)
+}
+
+// PinChatMessage adds a message to the list of pinned messages in a chat.
+func (b *Bot) PinChatMessage(ctx context.Context, chatID ChatID, messageID int, disableNotification bool) error {
+	params := PinChatMessageParams{
+		ChatID:              chatID,
+		MessageID:           messageID,
+		DisableNotification: disableNotification,
+	}
+
+	return params.do(ctx, b)
+}
+
+// UnpinChatMessage removes a message from the list of pinned messages in a chat.
+// A zero messageID unpins the most recent pinned message.
+func (b *Bot) UnpinChatMessage(ctx context.Context, chatID ChatID, messageID int) error {
+	return UnpinChatMessageParams{ChatID: chatID, MessageID: messageID}.do(ctx, b)
+}
+
+// UnpinAllChatMessages clears the list of pinned messages in a chat.
+func (b *Bot) UnpinAllChatMessages(ctx context.Context, chatID ChatID) error {
+	return UnpinAllChatMessagesParams{ChatID: chatID}.do(ctx, b)
+}
+
+// LeaveChat makes the bot leave a group, a supergroup or a channel.
+func (b *Bot) LeaveChat(ctx context.Context, chatID ChatID) error {
+	return LeaveChatParams{ChatID: chatID}.do(ctx, b)
+}
@@ -0,0 +1,147 @@
+package gotelegrambot_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/KazeDevID/gotelegrambot/telegramtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chatPhoto is the photo of the chat returned by getChat in these tests.
+var chatPhoto = map[string]interface{}{
+	"small_file_id":        "small",
+	"small_file_unique_id": "small-unique",
+	"big_file_id":          "big",
+	"big_file_unique_id":   "big-unique",
+}
+
+func TestGetChat(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	h.Server.Handle("getChat", func(call telegramtest.Call) (interface{}, error) {
+		return map[string]interface{}{
+			"id":                       call.Int64("chat_id"),
+			"type":                     "supergroup",
+			"title":                    "Group",
+			"accent_color_id":          3,
+			"max_reaction_count":       11,
+			"photo":                    chatPhoto,
+			"active_usernames":         []string{"group", "group_old"},
+			"description":              "About the group",
+			"pinned_message":           map[string]interface{}{"message_id": 7, "date": 0, "chat": map[string]interface{}{"id": -100123, "type": "supergroup"}, "text": "Rules"},
+			"permissions":              map[string]interface{}{"can_send_messages": true},
+			"slow_mode_delay":          30,
+			"has_hidden_members":       true,
+			"linked_chat_id":           -100999,
+			"join_by_request":          true,
+			"message_auto_delete_time": 86400,
+		}, nil
+	})
+
+	chat, err := h.Bot.GetChat(context.Background(), gotelegrambot.NewChatID(-100123))
+	require.NoError(t, err)
+	h.ExpectCall("getChat").Param("chat_id", -100123)
+
+	assert.Equal(t, int64(-100123), chat.ID)
+	assert.Equal(t, "supergroup", chat.Type)
+	assert.Equal(t, 3, chat.AccentColorID)
+	assert.Equal(t, 11, chat.MaxReactionCount)
+	assert.Equal(t, "big", chat.Photo.BigFileID)
+	assert.Equal(t, []string{"group", "group_old"}, chat.ActiveUsernames)
+	assert.Equal(t, "About the group", chat.Description)
+	require.NotNil(t, chat.PinnedMessage)
+	assert.Equal(t, "Rules", chat.PinnedMessage.Text)
+	require.NotNil(t, chat.Permissions)
+	assert.True(t, chat.Permissions.CanSendMessages)
+	assert.Equal(t, 30, chat.SlowModeDelay)
+	assert.True(t, chat.HasHiddenMembers)
+	assert.True(t, chat.JoinByRequest)
+	assert.Equal(t, int64(-100999), chat.LinkedChatID)
+	assert.Equal(t, 86400, chat.MessageAutoDeleteTime)
+}
+
+func TestSetChatPhoto(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+
+	photoPath := filepath.Join(t.TempDir(), "photo.jpg")
+	require.NoError(t, os.WriteFile(photoPath, []byte("jpeg data"), 0o600))
+
+	require.NoError(t, h.Bot.SetChatPhoto(context.Background(), gotelegrambot.NewChatUsername("group_chat"), photoPath))
+	call := h.ExpectCall("setChatPhoto").
+		Param("chat_id", "@group_chat").
+		Param("photo", "attach://photo").
+		Call()
+	assert.Equal(t, []byte("jpeg data"), call.Files["photo"])
+
+	err := h.Bot.SetChatPhoto(context.Background(), gotelegrambot.NewChatID(-100123), filepath.Join(t.TempDir(), "missing.jpg"))
+	assert.Error(t, err)
+	assert.Len(t, h.Server.CallsTo("setChatPhoto"), 1)
+}
+
+func TestDownloadChatPhoto(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	ctx := context.Background()
+	group := gotelegrambot.NewChatID(-100123)
+
+	photo := interface{}(chatPhoto)
+	h.Server.Handle("getChat", func(call telegramtest.Call) (interface{}, error) {
+		return map[string]interface{}{"id": -100123, "type": "supergroup", "photo": photo}, nil
+	})
+	h.Server.Handle("getFile", func(call telegramtest.Call) (interface{}, error) {
+		fileID := call.String("file_id")
+		return gotelegrambot.File{FileID: fileID, FileUniqueID: fileID + "-unique", FilePath: "photos/" + fileID + ".jpg"}, nil
+	})
+	h.Server.AddFile("photos/small.jpg", []byte("small photo"))
+	h.Server.AddFile("photos/big.jpg", []byte("big photo"))
+
+	file, err := h.Bot.GetChatPhotoFile(ctx, group, false)
+	require.NoError(t, err)
+	assert.Equal(t, "photos/small.jpg", file.FilePath)
+	assert.Equal(t, h.Server.URL+"/file/bot"+telegramtest.Token+"/photos/small.jpg", file.URL)
+	h.ExpectCall("getChat").Param("chat_id", -100123)
+	h.ExpectCall("getFile").Param("file_id", "small")
+
+	dest := filepath.Join(t.TempDir(), "photo.jpg")
+	require.NoError(t, h.Bot.DownloadChatPhoto(ctx, group, true, dest))
+	data, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, "big photo", string(data))
+	h.ExpectCall("getFile").Param("file_id", "big")
+
+	photo = nil
+	_, err = h.Bot.GetChatPhotoFile(ctx, group, true)
+	assert.EqualError(t, err, "chat has no photo")
+}
+
+func TestPinAndLeaveChat(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	ctx := context.Background()
+	group := gotelegrambot.NewChatID(-100123)
+
+	require.NoError(t, h.Bot.PinChatMessage(ctx, group, 7, true))
+	require.NoError(t, h.Bot.UnpinChatMessage(ctx, group, 7))
+	require.NoError(t, h.Bot.UnpinChatMessage(ctx, group, 0))
+	require.NoError(t, h.Bot.UnpinAllChatMessages(ctx, group))
+	require.NoError(t, h.Bot.LeaveChat(ctx, group))
+
+	h.ExpectCalls("pinChatMessage", "unpinChatMessage", "unpinChatMessage", "unpinAllChatMessages", "leaveChat")
+	h.ExpectCall("pinChatMessage").
+		Param("chat_id", -100123).
+		Param("message_id", 7).
+		Param("disable_notification", true)
+	h.ExpectCall("unpinChatMessage").Param("message_id", 7)
+	h.ExpectCall("unpinChatMessage").NoParam("message_id")
+	h.ExpectCall("unpinAllChatMessages").Param("chat_id", -100123)
+	h.ExpectCall("leaveChat").Param("chat_id", -100123)
+
+	h.Server.Fail("leaveChat", telegramtest.Failure{Code: 400, Description: "Bad Request: chat not found"})
+	err := h.Bot.LeaveChat(ctx, group)
+	assert.ErrorContains(t, err, "chat not found")
+
+	err = h.Bot.LeaveChat(ctx, gotelegrambot.ChatID{})
+	assert.EqualError(t, err, "invalid chat_id: chat ID is required")
+}
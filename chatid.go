@@ -0,0 +1,132 @@
+package gotelegrambot
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ChatID identifies the target chat of a method, either by its numeric ID or,
+// for channels and public supergroups, by its @username. The zero ChatID is
+// unset; required chat IDs are rejected before the request is sent.
+type ChatID struct {
+	id       int64
+	username string
+}
+
+// NewChatID returns the ChatID of the chat with the numeric ID id.
+func NewChatID(id int64) ChatID {
+	return ChatID{id: id}
+}
+
+// NewChatUsername returns the ChatID of the channel or supergroup with the
+// given username. The leading @ may be omitted.
+func NewChatUsername(username string) ChatID {
+	if username == "" {
+		return ChatID{}
+	}
+	return ChatID{username: "@" + strings.TrimPrefix(username, "@")}
+}
+
+// ParseChatID parses a numeric chat ID or an @username.
+func ParseChatID(s string) (ChatID, error) {
+	if strings.HasPrefix(s, "@") {
+		chatID := ChatID{username: s}
+		return chatID, chatID.Validate()
+	}
+
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return ChatID{}, errors.Errorf("invalid chat ID %q", s)
+	}
+	return NewChatID(id), nil
+}
+
+// ChatID returns the ChatID of the chat.
+func (c *Chat) ChatID() ChatID {
+	if c == nil {
+		return ChatID{}
+	}
+	return NewChatID(c.ID)
+}
+
+// ChatID returns the ChatID of the chat the message belongs to.
+func (m *Message) ChatID() ChatID {
+	if m == nil {
+		return ChatID{}
+	}
+	return m.Chat.ChatID()
+}
+
+// ID returns the numeric ID, or 0 for a username.
+func (c ChatID) ID() int64 {
+	return c.id
+}
+
+// Username returns the @username, or "" for a numeric ID.
+func (c ChatID) Username() string {
+	return c.username
+}
+
+// IsZero reports whether the ChatID is unset.
+func (c ChatID) IsZero() bool {
+	return c.id == 0 && c.username == ""
+}
+
+// String returns the numeric ID or the @username.
+func (c ChatID) String() string {
+	if c.username != "" {
+		return c.username
+	}
+	return strconv.FormatInt(c.id, 10)
+}
+
+// usernamePattern matches the @usernames of Telegram chats.
+var usernamePattern = regexp.MustCompile(`^@[A-Za-z][A-Za-z0-9_]{3,31}$`)
+
+// Validate checks that the ChatID is set and, for usernames, well formed.
+func (c ChatID) Validate() error {
+	switch {
+	case c.IsZero():
+		return errors.New("chat ID is required")
+	case c.username != "" && !usernamePattern.MatchString(c.username):
+		return errors.Errorf("invalid chat username %q", c.username)
+	}
+	return nil
+}
+
+// value returns the ID or username as the Bot API expects it.
+func (c ChatID) value() interface{} {
+	if c.username != "" {
+		return c.username
+	}
+	return c.id
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c ChatID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.value())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ChatID) UnmarshalJSON(data []byte) error {
+	var username string
+	if err := json.Unmarshal(data, &username); err == nil {
+		parsed, err := ParseChatID(username)
+		if err != nil {
+			return err
+		}
+		*c = parsed
+		return nil
+	}
+
+	var id int64
+	if err := json.Unmarshal(data, &id); err != nil {
+		return errors.Wrap(err, "chat ID must be an integer or a string")
+	}
+	*c = NewChatID(id)
+	return nil
+}
@@ -0,0 +1,63 @@
+package gotelegrambot
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChatID(t *testing.T) {
+	chatID := NewChatID(-1001234)
+	assert.Equal(t, int64(-1001234), chatID.ID())
+	assert.Empty(t, chatID.Username())
+	assert.Equal(t, "-1001234", chatID.String())
+	assert.NoError(t, chatID.Validate())
+
+	channel := NewChatUsername("mychannel")
+	assert.Equal(t, "@mychannel", channel.Username())
+	assert.Equal(t, channel, NewChatUsername("@mychannel"))
+	assert.NoError(t, channel.Validate())
+
+	assert.True(t, ChatID{}.IsZero())
+	assert.True(t, NewChatUsername("").IsZero())
+	assert.EqualError(t, ChatID{}.Validate(), "chat ID is required")
+	assert.EqualError(t, NewChatUsername("a b").Validate(), `invalid chat username "@a b"`)
+
+	message := &Message{Chat: &Chat{ID: 42}}
+	assert.Equal(t, NewChatID(42), message.ChatID())
+	assert.True(t, (*Message)(nil).ChatID().IsZero())
+}
+
+func TestParseChatID(t *testing.T) {
+	chatID, err := ParseChatID("42")
+	require.NoError(t, err)
+	assert.Equal(t, NewChatID(42), chatID)
+
+	chatID, err = ParseChatID("@mychannel")
+	require.NoError(t, err)
+	assert.Equal(t, NewChatUsername("mychannel"), chatID)
+
+	_, err = ParseChatID("mychannel")
+	assert.EqualError(t, err, `invalid chat ID "mychannel"`)
+}
+
+func TestChatIDJSON(t *testing.T) {
+	data, err := json.Marshal(ReplyParameters{MessageID: 1, ChatID: &ChatID{username: "@mychannel"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"message_id":1,"chat_id":"@mychannel"}`, string(data))
+
+	data, err = json.Marshal(ReplyParameters{MessageID: 1})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"message_id":1}`, string(data))
+
+	var reply ReplyParameters
+	require.NoError(t, json.Unmarshal([]byte(`{"message_id":1,"chat_id":-100}`), &reply))
+	assert.Equal(t, NewChatID(-100), *reply.ChatID)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"message_id":1,"chat_id":"@mychannel"}`), &reply))
+	assert.Equal(t, NewChatUsername("mychannel"), *reply.ChatID)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"chat_id":true}`), &reply))
+}
@@ -10,99 +10,208 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
-// makeRequest is the internal method that actually performs the API request.
-func (b *Bot) makeRequest(ctx context.Context, method string, params interface{}, result interface{}) error {
+// makeRequest is the internal method used by all API methods. The call goes
+// through the API middlewares before it is sent.
+func (b *Bot) makeRequest(ctx context.Context, method string, params map[string]interface{}, result interface{}) error {
+	return b.invoke(ctx, &APICall{
+		Method: method,
+		Params: params,
+		Result: result,
+		Header: make(http.Header),
+	})
+}
+
+// execute sends the call. It resends it to the new chat when the target group
+// was migrated to a supergroup.
+func (b *Bot) execute(ctx context.Context, call *APICall) error {
+	err := b.sendCall(ctx, call)
+	if migrated, ok := b.migrateRequest(ctx, call.Params, err); ok {
+		retry := *call
+		retry.Params = migrated
+		return b.sendCall(ctx, &retry)
+	}
+	return err
+}
+
+func (b *Bot) sendCall(ctx context.Context, call *APICall) error {
+	if call.Files != nil {
+		return b.doMultipartRequest(ctx, call)
+	}
+	return b.doRequest(ctx, call)
+}
+
+// doRequest actually performs the API request.
+func (b *Bot) doRequest(ctx context.Context, call *APICall) error {
 	// Prepare URL
-	url := b.APIEndpoint + "/" + method
+	url := b.APIEndpoint + "/" + call.Method
 
-	var req *http.Request
-	var err error
+	if len(call.Params) == 0 {
+		return b.send(ctx, call, func() (*http.Request, error) {
+			return http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		})
+	}
 
-	// Create the request
-	if params != nil {
-		jsonData, err := json.Marshal(params)
-		if err != nil {
-			return errors.Wrap(err, "failed to marshal request params")
-		}
+	jsonData, err := json.Marshal(call.Params)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal request params")
+	}
 
-		req, err = http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
-		if err != nil {
-			return errors.Wrap(err, "failed to create request")
-		}
+	if b.logEnabled(LogLevelTrace) {
+		b.log(LogLevelTrace, "request body", "method", call.Method, "body", string(redactJSON(call.Params, jsonData)))
+	}
 
-		req.Header.Set("Content-Type", "application/json")
-	} else {
-		req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	return b.send(ctx, call, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(jsonData))
 		if err != nil {
-			return errors.Wrap(err, "failed to create request")
+			return nil, err
 		}
-	}
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
+	})
+}
 
-	// Make the request with retries
-	var resp *http.Response
-	var lastErr error
+// send performs a request built by newRequest, retrying on network errors and
+// waiting out rate limits, and parses the response into the call's result.
+func (b *Bot) send(ctx context.Context, call *APICall, newRequest func() (*http.Request, error)) error {
+	method := call.Method
+	start := time.Now()
+	var err error
+	var retry bool
 
 	for i := 0; i <= b.retryCount; i++ {
 		if i > 0 {
-			// Wait before retrying
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(time.Duration(i) * time.Second):
-				// Exponential backoff
+			b.instruments().APIRetry(method)
+
+			// Wait before retrying, as long as Telegram asked for rate limits
+			wait := time.Duration(i) * time.Second
+			var apiErr *Error
+			if errors.As(err, &apiErr) && apiErr.WaitTime() > 0 {
+				wait = time.Duration(apiErr.WaitTime()) * time.Second
+				b.instruments().RateLimitWait(method, wait)
+			}
+
+			if err := b.sleep(ctx, wait); err != nil {
+				return err
 			}
 		}
 
-		resp, err = b.Client.Do(req)
-		if err == nil {
+		retry, err = b.sendOnce(ctx, call, newRequest)
+		if !retry {
 			break
 		}
 
-		lastErr = err
-		b.debug("Request failed (attempt %d/%d): %v", i+1, b.retryCount+1, err)
+		b.log(LogLevelWarn, "request failed", "method", method, "attempt", i+1, "attempts", b.retryCount+1, "error", err)
+	}
+
+	b.instruments().APIRequest(method, time.Since(start), err)
+
+	var apiErr *Error
+	if retry && !errors.As(err, &apiErr) {
+		return errors.Wrap(err, "request failed after retries")
 	}
+	return err
+}
 
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// sendOnce performs a single attempt of a request. It reports whether the
+// attempt failed in a way worth retrying.
+func (b *Bot) sendOnce(ctx context.Context, call *APICall, newRequest func() (*http.Request, error)) (bool, error) {
+	method := call.Method
+	req, err := newRequest()
 	if err != nil {
-		return errors.Wrap(lastErr, "request failed after retries")
+		return false, errors.Wrap(err, "failed to create request")
+	}
+
+	for key, values := range call.Header {
+		req.Header[key] = values
+	}
+
+	start := time.Now()
+	resp, err := b.Client.Do(req)
+	if err != nil {
+		return true, b.redactError(err)
 	}
 	defer resp.Body.Close()
 
+	spanFromContext(ctx).SetAttributes(Attr(AttrHTTPStatusCode, resp.StatusCode))
+
 	// Read response body
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrap(err, "failed to read response body")
+		return true, errors.Wrap(err, "failed to read response body")
 	}
 
-	b.debug("Response: %s", string(body))
+	b.logResponse(method, resp.StatusCode, time.Since(start), body)
 
 	// Parse response
-	if err := ParseAPIResponse(body, result); err != nil {
+	if err := ParseAPIResponse(body, call.Result); err != nil {
 		var apiErr *Error
-		if errors.As(err, &apiErr) {
-			apiErr.Response = resp
+		if !errors.As(err, &apiErr) {
+			return false, err
 		}
-		return err
+
+		apiErr.Response = resp
+		apiErr.Method = method
+		apiErr.Params = redactParams(call.Params)
+		b.log(LogLevelWarn, "api error", "method", method, "code", apiErr.Code, "description", apiErr.Message)
+		return apiErr.WaitTime() > 0, err
 	}
 
-	return nil
+	return false, nil
+}
+
+// logResponse logs a completed request, and its body at trace level.
+func (b *Bot) logResponse(method string, status int, duration time.Duration, body []byte) {
+	b.log(LogLevelDebug, "api request", "method", method, "status", status, "duration", duration)
+	if b.logEnabled(LogLevelTrace) {
+		b.log(LogLevelTrace, "response body", "method", method, "body", string(body))
+	}
 }
 
 // makeMultipartRequest makes a multipart request for uploading files.
 func (b *Bot) makeMultipartRequest(ctx context.Context, method string, params map[string]interface{}, files map[string]string, result interface{}) error {
+	if files == nil {
+		files = map[string]string{}
+	}
+
+	return b.invoke(ctx, &APICall{
+		Method: method,
+		Params: params,
+		Files:  files,
+		Result: result,
+		Header: make(http.Header),
+	})
+}
+
+// doMultipartRequest actually performs the multipart request.
+func (b *Bot) doMultipartRequest(ctx context.Context, call *APICall) error {
 	// Prepare URL
-	url := b.APIEndpoint + "/" + method
+	url := b.APIEndpoint + "/" + call.Method
 
 	// Create multipart writer
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
 	// Add files to the multipart writer
-	for field, filePath := range files {
+	for field, filePath := range call.Files {
 		file, err := os.Open(filePath)
 		if err != nil {
 			return errors.Wrapf(err, "failed to open file %s", filePath)
@@ -120,7 +229,7 @@ func (b *Bot) makeMultipartRequest(ctx context.Context, method string, params ma
 	}
 
 	// Add other parameters
-	for key, value := range params {
+	for key, value := range call.Params {
 		if value == nil {
 			continue
 		}
@@ -151,60 +260,14 @@ func (b *Bot) makeMultipartRequest(ctx context.Context, method string, params ma
 		return errors.Wrap(err, "failed to close multipart writer")
 	}
 
-	// Create request
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
-	if err != nil {
-		return errors.Wrap(err, "failed to create request")
-	}
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
-	// Make the request with retries
-	var resp *http.Response
-	var lastErr error
-
-	for i := 0; i <= b.retryCount; i++ {
-		if i > 0 {
-			// Wait before retrying
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case <-time.After(time.Duration(i) * time.Second):
-				// Exponential backoff
-			}
-		}
-
-		resp, err = b.Client.Do(req)
-		if err == nil {
-			break
-		}
-
-		lastErr = err
-		b.debug("Request failed (attempt %d/%d): %v", i+1, b.retryCount+1, err)
-	}
-
-	if err != nil {
-		return errors.Wrap(lastErr, "request failed after retries")
-	}
-	defer resp.Body.Close()
-
-	// Read response body
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrap(err, "failed to read response body")
-	}
-
-	b.debug("Response: %s", string(responseBody))
-
-	// Parse response
-	if err := ParseAPIResponse(responseBody, result); err != nil {
-		var apiErr *Error
-		if errors.As(err, &apiErr) {
-			apiErr.Response = resp
+	return b.send(ctx, call, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body.Bytes()))
+		if err != nil {
+			return nil, err
 		}
-		return err
-	}
-
-	return nil
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req, nil
+	})
 }
 
 // IsInputFile checks if the interface is meant to be uploaded as a file.
@@ -224,20 +287,21 @@ func IsInputFile(file interface{}) bool {
 
 // GetFile gets information about a file.
 func (b *Bot) GetFile(ctx context.Context, fileID string) (*File, error) {
-	params := map[string]interface{}{
-		"file_id": fileID,
-	}
-
-	var file File
-	err := b.makeRequest(ctx, "getFile", params, &file)
+	file, err := GetFileParams{FileID: fileID}.do(ctx, b)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get file")
 	}
 
-	// Set file URL
-	file.URL = fmt.Sprintf("https://api.telegram.org/file/bot%s/%s", b.Token, file.FilePath)
+	b.setFileURL(file)
+	return file, nil
+}
 
-	return &file, nil
+// setFileURL sets the URL of a file, served next to the API endpoint.
+func (b *Bot) setFileURL(file *File) {
+	file.URL = fmt.Sprintf("https://api.telegram.org/file/bot%s/%s", b.Token, file.FilePath)
+	if i := strings.LastIndex(b.APIEndpoint, "/bot"); i >= 0 {
+		file.URL = b.APIEndpoint[:i] + "/file" + b.APIEndpoint[i:] + "/" + file.FilePath
+	}
 }
 
 // File represents a file ready to be downloaded.
@@ -264,7 +328,7 @@ func (b *Bot) DownloadFile(ctx context.Context, file *File, destPath string) err
 	// Make request
 	resp, err := b.Client.Do(req)
 	if err != nil {
-		return errors.Wrap(err, "failed to download file")
+		return errors.Wrap(b.redactError(err), "failed to download file")
 	}
 	defer resp.Body.Close()
 
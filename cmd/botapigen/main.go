@@ -0,0 +1,838 @@
+// Command botapigen generates types, params structs and methods of
+// gotelegrambot from the Bot API specification checked in at api/botapi.json.
+// It is run by go generate in the root package:
+//
+//	go generate ./...
+//
+// Every method gets a params struct accepted by Bot.Do. Types and Bot methods
+// already declared by hand in the package are skipped, so that hand-written
+// code can be replaced by generated code one piece at a time by deleting it
+// and regenerating; hand-written methods build the params struct and call its
+// do method.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// Spec is the machine-readable Bot API specification.
+type Spec struct {
+	Version     string                `json:"version"`
+	ReleaseDate string                `json:"release_date"`
+	Changelog   string                `json:"changelog"`
+	Methods     map[string]SpecMethod `json:"methods"`
+	Types       map[string]SpecType   `json:"types"`
+}
+
+// SpecMethod is a Bot API method.
+type SpecMethod struct {
+	Name        string      `json:"name"`
+	Href        string      `json:"href"`
+	Description []string    `json:"description"`
+	Returns     []string    `json:"returns"`
+	Fields      []SpecField `json:"fields"`
+}
+
+// SpecType is a Bot API type. Union types list their variants in Subtypes
+// and have no fields.
+type SpecType struct {
+	Name        string      `json:"name"`
+	Href        string      `json:"href"`
+	Description []string    `json:"description"`
+	Fields      []SpecField `json:"fields"`
+	Subtypes    []string    `json:"subtypes"`
+	SubtypeOf   []string    `json:"subtype_of"`
+}
+
+// SpecField is a field of a type or a parameter of a method.
+type SpecField struct {
+	Name        string   `json:"name"`
+	Types       []string `json:"types"`
+	Required    bool     `json:"required"`
+	Description string   `json:"description"`
+}
+
+// config represents the flags of the command.
+type config struct {
+	Spec    string
+	Dir     string
+	Types   string
+	Methods string
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.Spec, "spec", "api/botapi.json", "Bot API specification")
+	flag.StringVar(&cfg.Dir, "dir", ".", "directory of the package")
+	flag.StringVar(&cfg.Types, "types", "types_gen.go", "file to generate types into, relative to dir")
+	flag.StringVar(&cfg.Methods, "methods", "methods_gen.go", "file to generate params and methods into, relative to dir")
+	flag.Parse()
+
+	files, err := generate(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(cfg.Dir, name), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the formatted generated files by name.
+func generate(cfg config) (map[string][]byte, error) {
+	data, err := os.ReadFile(cfg.Spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read spec")
+	}
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, errors.Wrap(err, "failed to parse spec")
+	}
+
+	declared, err := declarations(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{spec: spec, declared: declared}
+
+	types, err := g.types()
+	if err != nil {
+		return nil, err
+	}
+	methods, err := g.methods()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{cfg.Types: types, cfg.Methods: methods}, nil
+}
+
+// declared holds the names declared by hand in the package.
+type declared struct {
+	Types      map[string]bool
+	Interfaces map[string]bool
+	Methods    map[string]bool
+}
+
+// declarations parses the hand-written files of the package.
+func declarations(cfg config) (declared, error) {
+	d := declared{Types: make(map[string]bool), Interfaces: make(map[string]bool), Methods: make(map[string]bool)}
+
+	paths, err := filepath.Glob(filepath.Join(cfg.Dir, "*.go"))
+	if err != nil {
+		return d, err
+	}
+
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if strings.HasSuffix(name, "_test.go") || name == cfg.Types || name == cfg.Methods {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return d, errors.Wrapf(err, "failed to parse %s", name)
+		}
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						d.Types[spec.Name.Name] = true
+						if _, ok := spec.Type.(*ast.InterfaceType); ok {
+							d.Interfaces[spec.Name.Name] = true
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv != nil && receiverName(decl.Recv.List[0].Type) == "Bot" {
+					d.Methods[decl.Name.Name] = true
+				}
+			}
+		}
+	}
+	return d, nil
+}
+
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// generator holds the state of a generation.
+type generator struct {
+	spec     Spec
+	declared declared
+}
+
+func (g *generator) header(buf *bytes.Buffer, imports ...string) {
+	fmt.Fprintf(buf, "// Code generated by botapigen from api/botapi.json (%s). DO NOT EDIT.\n\n", g.spec.Version)
+	buf.WriteString("package gotelegrambot\n\n")
+	if len(imports) > 0 {
+		buf.WriteString("import (\n")
+		for _, path := range imports {
+			fmt.Fprintf(buf, "%q\n", path)
+		}
+		buf.WriteString(")\n\n")
+	}
+}
+
+// types generates the types not declared by hand.
+func (g *generator) types() ([]byte, error) {
+	var buf bytes.Buffer
+	g.header(&buf, "encoding/json")
+
+	for _, name := range sortedKeys(g.spec.Types) {
+		t := g.spec.Types[name]
+		goType := typeName(name)
+		if _, ok := g.spec.Types[goType]; ok && goType != name {
+			// Unions such as MaybeInaccessibleMessage decode into one of their variants
+			continue
+		}
+		if name == "InputFile" || g.declared.Types[goType] {
+			continue
+		}
+
+		if len(t.Subtypes) > 0 {
+			if err := g.union(&buf, goType, t); err != nil {
+				return nil, errors.Wrapf(err, "type %s", name)
+			}
+			continue
+		}
+
+		fmt.Fprintf(&buf, "// %s\n", typeDoc(goType, t.Description))
+		if len(t.Fields) == 0 {
+			fmt.Fprintf(&buf, "type %s struct{}\n\n", goType)
+			continue
+		}
+		fmt.Fprintf(&buf, "type %s struct {\n", goType)
+		for _, field := range t.Fields {
+			typ, err := g.structFieldType(field)
+			if err != nil {
+				return nil, errors.Wrapf(err, "type %s", name)
+			}
+			fmt.Fprintf(&buf, "%s %s %s\n", goName(field.Name), typ, tag(field))
+		}
+		buf.WriteString("}\n\n")
+
+		if len(t.SubtypeOf) == 1 {
+			if err := g.variantMarshaler(&buf, goType, t); err != nil {
+				return nil, errors.Wrapf(err, "type %s", name)
+			}
+		}
+	}
+
+	return formatSource(buf.Bytes())
+}
+
+// structFieldType returns the Go type of a field of a generated struct.
+func (g *generator) structFieldType(field SpecField) (string, error) {
+	typ, err := g.fieldType(field)
+	if err != nil {
+		return "", err
+	}
+	if typ == "ChatID" && !field.Required {
+		// encoding/json does not omit empty structs
+		typ = "*ChatID"
+	}
+	return typ, nil
+}
+
+// discriminator returns the field holding a fixed value in every variant of a
+// union and its value by variant, or "" when the variants have no such field.
+// Unless distinct is false, the value must tell the variants apart; cached and
+// uncached inline query results share their types, for example.
+func (g *generator) discriminator(union SpecType, distinct bool) (string, map[string]string) {
+	first, ok := g.spec.Types[union.Subtypes[0]]
+	if !ok {
+		return "", nil
+	}
+
+candidates:
+	for _, candidate := range first.Fields {
+		values := make(map[string]string)
+		seen := make(map[string]bool)
+		for _, name := range union.Subtypes {
+			var value string
+			for _, field := range g.spec.Types[name].Fields {
+				if field.Name != candidate.Name || !field.Required {
+					continue
+				}
+				if match := discriminatorValue.FindStringSubmatch(field.Description); match != nil {
+					value = match[1]
+				}
+			}
+			if value == "" || distinct && seen[value] {
+				continue candidates
+			}
+			values[name] = value
+			seen[value] = true
+		}
+		return candidate.Name, values
+	}
+	return "", nil
+}
+
+// discriminatorValue matches the value a field always has in a union variant.
+var discriminatorValue = regexp.MustCompile(`(?:always|must be) “?([a-z_0-9]+)”?`)
+
+// union generates a struct holding one of the variants of a union type, with
+// the discriminator and the required fields common to all variants.
+func (g *generator) union(buf *bytes.Buffer, goType string, t SpecType) error {
+	key, values := g.discriminator(t, true)
+	if key == "" {
+		return errors.New("union has no discriminator field")
+	}
+
+	var common []SpecField
+	for _, field := range g.spec.Types[t.Subtypes[0]].Fields {
+		if !field.Required {
+			continue
+		}
+		typ, err := g.structFieldType(field)
+		if err != nil {
+			return err
+		}
+		shared := true
+		for _, name := range t.Subtypes[1:] {
+			found := false
+			for _, other := range g.spec.Types[name].Fields {
+				if other.Name != field.Name || !other.Required {
+					continue
+				}
+				otherType, err := g.structFieldType(other)
+				if err != nil {
+					return err
+				}
+				found = otherType == typ
+			}
+			shared = shared && found
+		}
+		if shared {
+			common = append(common, field)
+		}
+	}
+
+	variants := make([]string, len(t.Subtypes))
+	for i, name := range t.Subtypes {
+		variants[i] = strings.TrimPrefix(typeName(name), goType)
+		for _, field := range common {
+			if goName(field.Name) == variants[i] {
+				return errors.Errorf("variant %s collides with field %s", name, field.Name)
+			}
+		}
+	}
+
+	keyName := goName(key)
+	fmt.Fprintf(buf, "// %s Exactly one of the variant fields is set, according to %s.\n", typeDoc(goType, t.Description), keyName)
+	fmt.Fprintf(buf, "type %s struct {\n", goType)
+	var head bytes.Buffer
+	for _, field := range common {
+		typ, _ := g.structFieldType(field)
+		fmt.Fprintf(buf, "%s %s\n", goName(field.Name), typ)
+		fmt.Fprintf(&head, "%s %s %s\n", goName(field.Name), typ, tag(field))
+	}
+	buf.WriteString("\n")
+	for i, name := range t.Subtypes {
+		fmt.Fprintf(buf, "%s *%s\n", variants[i], typeName(name))
+	}
+	buf.WriteString("}\n\n")
+
+	recv := receiverOf(goType)
+	fmt.Fprintf(buf, "// UnmarshalJSON decodes the %s into the variant matching its %s.\n", goType, key)
+	fmt.Fprintf(buf, "func (%s *%s) UnmarshalJSON(data []byte) error {\n", recv, goType)
+	fmt.Fprintf(buf, "var head struct {\n%s}\n", head.String())
+	buf.WriteString("if err := json.Unmarshal(data, &head); err != nil {\nreturn err\n}\n\n")
+	fields := make([]string, len(common))
+	for i, field := range common {
+		fields[i] = fmt.Sprintf("%s: head.%s", goName(field.Name), goName(field.Name))
+	}
+	fmt.Fprintf(buf, "*%s = %s{%s}\n\n", recv, goType, strings.Join(fields, ", "))
+	buf.WriteString("var variant interface{}\n")
+	fmt.Fprintf(buf, "switch head.%s {\n", keyName)
+	for i, name := range t.Subtypes {
+		fmt.Fprintf(buf, "case %q:\n", values[name])
+		fmt.Fprintf(buf, "%s.%s = &%s{}\n", recv, variants[i], typeName(name))
+		fmt.Fprintf(buf, "variant = %s.%s\n", recv, variants[i])
+	}
+	buf.WriteString("default:\nreturn nil\n}\n\nreturn json.Unmarshal(data, variant)\n}\n\n")
+
+	buf.WriteString("// MarshalJSON encodes the variant that is set.\n")
+	fmt.Fprintf(buf, "func (%s %s) MarshalJSON() ([]byte, error) {\n", recv, goType)
+	buf.WriteString("switch {\n")
+	for _, variant := range variants {
+		fmt.Fprintf(buf, "case %s.%s != nil:\n", recv, variant)
+		fmt.Fprintf(buf, "return json.Marshal(%s.%s)\n", recv, variant)
+	}
+	buf.WriteString("}\n")
+	fmt.Fprintf(buf, "return json.Marshal(struct {\n%s}{%s})\n}\n\n", head.String(), strings.Join(prefixed(recv+".", common), ", "))
+	return nil
+}
+
+// variantMarshaler generates a MarshalJSON method setting the discriminator
+// of a union variant, so that callers do not have to.
+func (g *generator) variantMarshaler(buf *bytes.Buffer, goType string, t SpecType) error {
+	union, ok := g.spec.Types[t.SubtypeOf[0]]
+	if !ok {
+		return errors.Errorf("unknown union %s", t.SubtypeOf[0])
+	}
+	key, values := g.discriminator(union, false)
+	if key == "" {
+		return nil
+	}
+
+	recv := receiverOf(goType)
+	fmt.Fprintf(buf, "// MarshalJSON encodes the %s with its %s set.\n", goType, key)
+	fmt.Fprintf(buf, "func (%s %s) MarshalJSON() ([]byte, error) {\n", recv, goType)
+	fmt.Fprintf(buf, "type variant %s\n", goType)
+	fmt.Fprintf(buf, "%s.%s = %q\n", recv, goName(key), values[t.Name])
+	fmt.Fprintf(buf, "return json.Marshal(variant(%s))\n}\n\n", recv)
+	return nil
+}
+
+func prefixed(prefix string, fields []SpecField) []string {
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		values[i] = prefix + goName(field.Name)
+	}
+	return values
+}
+
+// receiverOf returns the receiver name of a generated method of a type.
+func receiverOf(goType string) string {
+	return strings.ToLower(goType[:1])
+}
+
+// methods generates the params structs of all methods, which Bot.Do accepts,
+// and the Bot methods not declared by hand.
+func (g *generator) methods() ([]byte, error) {
+	var buf bytes.Buffer
+	g.header(&buf, "context")
+
+	for _, name := range sortedKeys(g.spec.Methods) {
+		m := g.spec.Methods[name]
+		goMethod := exported(name)
+		paramsType := goMethod + "Params"
+
+		result, err := g.result(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "method %s", name)
+		}
+
+		var files []string
+		fmt.Fprintf(&buf, "// %s represents the params of %s.\n", paramsType, goMethod)
+		if len(m.Fields) == 0 {
+			fmt.Fprintf(&buf, "type %s struct{}\n\n", paramsType)
+		} else {
+			fmt.Fprintf(&buf, "type %s struct {\n", paramsType)
+			for _, field := range m.Fields {
+				typ, err := g.fieldType(field)
+				if err != nil {
+					return nil, errors.Wrapf(err, "method %s", name)
+				}
+				if typ == "bool" && strings.HasPrefix(name, "edit") {
+					// Editing a flag to false must send it rather than leave it out
+					typ = "*bool"
+				}
+				if contains(field.Types, "InputFile") {
+					files = append(files, fmt.Sprintf("%q", field.Name))
+				}
+				fmt.Fprintf(&buf, "%s %s %s\n", goName(field.Name), typ, tag(field))
+			}
+			buf.WriteString("}\n\n")
+		}
+
+		fmt.Fprintf(&buf, "// Method returns %q.\n", name)
+		fmt.Fprintf(&buf, "func (%s) Method() string {\nreturn %q\n}\n\n", paramsType, name)
+
+		// Methods without parameters send no params at all
+		receiver, paramsArg := "", "nil"
+		if len(m.Fields) > 0 {
+			receiver, paramsArg = "p ", "p"
+		}
+		filesArg := "nil"
+		if len(files) > 0 {
+			filesArg = "[]string{" + strings.Join(files, ", ") + "}"
+		}
+		call := fmt.Sprintf("b.makeParamsRequest(ctx, %q, %s, %s", name, paramsArg, filesArg)
+
+		results := "error"
+		if result != "" {
+			results = "(" + result + ", error)"
+		}
+		fmt.Fprintf(&buf, "func (%s%s) do(ctx context.Context, b *Bot) %s {\n", receiver, paramsType, results)
+		switch {
+		case result == "":
+			fmt.Fprintf(&buf, "return %s, nil)\n}\n\n", call)
+		case result == "*Message" && contains(m.Returns, "Boolean"):
+			buf.WriteString("var result messageOrTrue\n")
+			fmt.Fprintf(&buf, "if err := %s, &result); err != nil {\n", call)
+			buf.WriteString("return nil, err\n}\n\nreturn result.Message, nil\n}\n\n")
+		case strings.HasPrefix(result, "*"):
+			fmt.Fprintf(&buf, "var result %s\n", result[1:])
+			fmt.Fprintf(&buf, "if err := %s, &result); err != nil {\n", call)
+			buf.WriteString("return nil, err\n}\n\nreturn &result, nil\n}\n\n")
+		default:
+			fmt.Fprintf(&buf, "var result %s\n", result)
+			fmt.Fprintf(&buf, "err := %s, &result)\n", call)
+			buf.WriteString("return result, err\n}\n\n")
+		}
+
+		// Methods returning only True have a bool result in Params
+		if result == "" {
+			fmt.Fprintf(&buf, "func (p %s) call(ctx context.Context, b *Bot) (bool, error) {\n", paramsType)
+			buf.WriteString("err := p.do(ctx, b)\nreturn err == nil, err\n}\n\n")
+		} else {
+			fmt.Fprintf(&buf, "func (p %s) call(ctx context.Context, b *Bot) (%s, error) {\n", paramsType, result)
+			buf.WriteString("return p.do(ctx, b)\n}\n\n")
+		}
+
+		if g.declared.Methods[goMethod] {
+			continue
+		}
+
+		fmt.Fprintf(&buf, "// %s\n", methodDoc(goMethod, m.Description))
+		if len(m.Fields) == 0 {
+			fmt.Fprintf(&buf, "func (b *Bot) %s(ctx context.Context) %s {\n", goMethod, results)
+			fmt.Fprintf(&buf, "return %s{}.do(ctx, b)\n}\n\n", paramsType)
+		} else {
+			fmt.Fprintf(&buf, "func (b *Bot) %s(ctx context.Context, params %s) %s {\n", goMethod, paramsType, results)
+			buf.WriteString("return params.do(ctx, b)\n}\n\n")
+		}
+	}
+
+	return formatSource(buf.Bytes())
+}
+
+// result returns the Go result type of a method, or "" when it only returns
+// True. Methods returning a Message or True return the Message, which is nil
+// for inline messages.
+func (g *generator) result(m SpecMethod) (string, error) {
+	returns := m.Returns
+	if len(returns) == 2 && returns[1] == "Boolean" {
+		returns = returns[:1]
+	}
+	if len(returns) != 1 {
+		return "", errors.Errorf("unsupported returns %v", m.Returns)
+	}
+
+	if returns[0] == "Boolean" {
+		return "", nil
+	}
+	return g.fieldType(SpecField{Types: returns, Required: true})
+}
+
+// fieldType returns the Go type of a field.
+func (g *generator) fieldType(field SpecField) (string, error) {
+	if isChatID(field) {
+		return "ChatID", nil
+	}
+	if isReplyMarkup(field) {
+		return "ReplyMarkup", nil
+	}
+	if union := g.commonUnion(field.Types); union != "" {
+		return union, nil
+	}
+	if len(field.Types) != 1 {
+		// Unions such as "InputFile or String"
+		return "interface{}", nil
+	}
+
+	typ := field.Types[0]
+	prefix := ""
+	for strings.HasPrefix(typ, "Array of ") {
+		typ = strings.TrimPrefix(typ, "Array of ")
+		prefix += "[]"
+	}
+
+	if prefix == "" && !field.Required && sendsZero(field) {
+		switch typ {
+		case "Integer":
+			return "*int", nil
+		case "String":
+			return "*string", nil
+		case "Boolean":
+			return "*bool", nil
+		}
+	}
+
+	switch typ {
+	case "Integer":
+		if isInt64(field) {
+			return prefix + "int64", nil
+		}
+		return prefix + "int", nil
+	case "Float":
+		return prefix + "float64", nil
+	case "String":
+		return prefix + "string", nil
+	case "Boolean", "True":
+		return prefix + "bool", nil
+	case "InputFile":
+		return prefix + "interface{}", nil
+	}
+
+	if _, ok := g.spec.Types[typ]; !ok && !g.declared.Types[typeName(typ)] {
+		return "", errors.Errorf("unknown type %s of %s", typ, field.Name)
+	}
+	typ = typeName(typ)
+	if prefix != "" || g.declared.Interfaces[typ] {
+		return prefix + typ, nil
+	}
+	return "*" + typ, nil
+}
+
+// commonUnion returns the Go type of a field accepting several variants of
+// the same union, such as "Array of InputMediaAudio or InputMediaPhoto", or
+// "" for other fields.
+func (g *generator) commonUnion(types []string) string {
+	if len(types) < 2 {
+		return ""
+	}
+	var prefix, union string
+	for _, typ := range types {
+		elem := strings.TrimLeft(strings.ReplaceAll(typ, "Array of ", "[]"), "[]")
+		t, ok := g.spec.Types[elem]
+		if !ok || len(t.SubtypeOf) != 1 || union != "" && t.SubtypeOf[0] != union {
+			return ""
+		}
+		union = t.SubtypeOf[0]
+		prefix = strings.TrimSuffix(strings.ReplaceAll(typ, "Array of ", "[]"), elem)
+	}
+	if prefix == "" && !g.declared.Interfaces[typeName(union)] {
+		return "*" + typeName(union)
+	}
+	return prefix + typeName(union)
+}
+
+// typeNames maps the Bot API types whose Go name differs. Unions without a
+// discriminator map to the variant they are decoded into.
+var typeNames = map[string]string{
+	"MessageId":                     "MessageID",
+	"LoginUrl":                      "LoginURL",
+	"TransactionPartnerTelegramApi": "TransactionPartnerTelegramAPI",
+	"MaybeInaccessibleMessage":      "Message",
+}
+
+// typeName returns the Go name of a Bot API type.
+func typeName(name string) string {
+	if goType, ok := typeNames[name]; ok {
+		return goType
+	}
+	return name
+}
+
+// zeroPhrases are the descriptions of fields whose zero value is meaningful.
+var zeroPhrases = []string{"0-based", "Pass an empty string to remove", "May be empty", "pass False"}
+
+// defaultValue matches the documented default of an optional parameter.
+var defaultValue = regexp.MustCompile(`(?i)defaults to (\w+)`)
+
+// sendsZero reports whether the zero value of an optional field means
+// something else than leaving it out, so that it needs a pointer: fields
+// defaulting to a non-zero value, 0-based indexes, strings that are emptied
+// by passing "" or may be empty, and flags whose false is a filter.
+func sendsZero(field SpecField) bool {
+	for _, phrase := range zeroPhrases {
+		if strings.Contains(field.Description, phrase) {
+			return true
+		}
+	}
+	if strings.Contains(field.Description, "an empty string if") &&
+		!strings.Contains(field.Description, "Pass an empty string if") {
+		return true
+	}
+	match := defaultValue.FindStringSubmatch(field.Description)
+	if match == nil {
+		return false
+	}
+	switch strings.ToLower(match[1]) {
+	case "0", "false":
+		return false
+	}
+	return true
+}
+
+// isChatID reports whether a field holds a chat ID or channel username.
+func isChatID(field SpecField) bool {
+	return len(field.Types) == 2 && field.Types[0] == "Integer" && field.Types[1] == "String"
+}
+
+// replyMarkups are the types of the ReplyMarkup union.
+var replyMarkups = []string{"InlineKeyboardMarkup", "ReplyKeyboardMarkup", "ReplyKeyboardRemove", "ForceReply"}
+
+// isReplyMarkup reports whether a field accepts any kind of reply markup.
+func isReplyMarkup(field SpecField) bool {
+	if len(field.Types) != len(replyMarkups) {
+		return false
+	}
+	for _, typ := range replyMarkups {
+		if !contains(field.Types, typ) {
+			return false
+		}
+	}
+	return true
+}
+
+// isInt64 reports whether an Integer field may not fit in 32 bits.
+func isInt64(field SpecField) bool {
+	if strings.Contains(field.Description, "64-bit") {
+		return true
+	}
+	return field.Name == "user_id" || field.Name == "chat_id" ||
+		strings.HasSuffix(field.Name, "_user_id") || strings.HasSuffix(field.Name, "_chat_id")
+}
+
+func tag(field SpecField) string {
+	if field.Required {
+		return fmt.Sprintf("`json:\"%s\"`", field.Name)
+	}
+	return fmt.Sprintf("`json:\"%s,omitempty\"`", field.Name)
+}
+
+// initialisms are the words spelled in capitals in Go names.
+var initialisms = map[string]string{
+	"id":    "ID",
+	"ids":   "IDs",
+	"ok":    "OK",
+	"url":   "URL",
+	"urls":  "URLs",
+	"ip":    "IP",
+	"html":  "HTML",
+	"json":  "JSON",
+	"api":   "API",
+	"vcard": "VCard",
+}
+
+// goName converts a snake_case name to a Go name.
+func goName(name string) string {
+	var b strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		if initialism, ok := initialisms[word]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		b.WriteString(exported(word))
+	}
+	return b.String()
+}
+
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// firstSentence returns the first sentence of a description.
+func firstSentence(description []string) string {
+	if len(description) == 0 {
+		return ""
+	}
+	text := description[0]
+	if i := strings.Index(text, ". "); i >= 0 {
+		text = text[:i+1]
+	}
+	if !strings.HasSuffix(text, ".") {
+		text += "."
+	}
+	return text
+}
+
+// typeDoc turns "This object represents a chat." into "Chat represents a chat.".
+func typeDoc(name string, description []string) string {
+	sentence := firstSentence(description)
+	if rest := strings.TrimPrefix(sentence, "This object "); rest != sentence {
+		if strings.HasPrefix(rest, "represent ") {
+			rest = "represents " + strings.TrimPrefix(rest, "represent ")
+		}
+		return name + " " + rest
+	}
+	return name + " " + lowerFirst(sentence)
+}
+
+// methodDoc turns "Use this method to send general files." into
+// "SendDocument sends general files.".
+func methodDoc(name string, description []string) string {
+	sentence := firstSentence(description)
+	if rest := strings.TrimPrefix(sentence, "Use this method to "); rest != sentence {
+		verb, tail, _ := strings.Cut(rest, " ")
+		return name + " " + thirdPerson(verb) + " " + tail
+	}
+	if strings.HasPrefix(sentence, "A ") || strings.HasPrefix(sentence, "An ") {
+		return name + " is " + lowerFirst(sentence)
+	}
+	return name + " " + lowerFirst(sentence)
+}
+
+func thirdPerson(verb string) string {
+	switch {
+	case strings.HasSuffix(verb, "s"), strings.HasSuffix(verb, "sh"), strings.HasSuffix(verb, "ch"), strings.HasSuffix(verb, "x"):
+		return verb + "es"
+	case strings.HasSuffix(verb, "y") && !strings.ContainsAny(verb[len(verb)-2:len(verb)-1], "aeiou"):
+		return verb[:len(verb)-1] + "ies"
+	}
+	return verb + "s"
+}
+
+func lowerFirst(text string) string {
+	if text == "" {
+		return text
+	}
+	runes := []rune(text)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatSource(src []byte) ([]byte, error) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to format generated code:\n%s", src)
+	}
+	return formatted, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedCodeUpToDate(t *testing.T) {
+	cfg := config{
+		Spec:    "../../api/botapi.json",
+		Dir:     "../..",
+		Types:   "types_gen.go",
+		Methods: "methods_gen.go",
+	}
+	files, err := generate(cfg)
+	require.NoError(t, err)
+
+	for name, src := range files {
+		current, err := os.ReadFile(filepath.Join(cfg.Dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, string(current), string(src), "%s is out of date, run go generate", name)
+	}
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, "FileUniqueID", goName("file_unique_id"))
+	assert.Equal(t, "ThumbnailURL", goName("thumbnail_url"))
+	assert.Equal(t, "VCard", goName("vcard"))
+
+	assert.Equal(t, "Chat represents a chat.", typeDoc("Chat", []string{"This object represents a chat."}))
+	assert.Equal(t, "SendDocument sends general files.",
+		methodDoc("SendDocument", []string{"Use this method to send general files. On success, the sent Message is returned."}))
+	assert.Equal(t, "SetMyName changes the bot's name.",
+		methodDoc("SetMyName", []string{"Use this method to change the bot's name. Returns True on success."}))
+}
+
+func TestFieldTypes(t *testing.T) {
+	g := &generator{spec: Spec{Types: map[string]SpecType{"PhotoSize": {}}}, declared: declared{Types: map[string]bool{"MessageID": true}}}
+
+	for _, tc := range []struct {
+		field SpecField
+		want  string
+	}{
+		{SpecField{Name: "update_id", Types: []string{"Integer"}}, "int"},
+		{SpecField{Name: "user_id", Types: []string{"Integer"}}, "int64"},
+		{SpecField{Name: "id", Types: []string{"Integer"}, Description: "a 64-bit integer"}, "int64"},
+		{SpecField{Name: "chat_id", Types: []string{"Integer", "String"}}, "ChatID"},
+		{SpecField{Name: "media", Types: []string{"InputFile", "String"}}, "interface{}"},
+		{SpecField{Name: "reply_markup", Types: []string{"InlineKeyboardMarkup", "ReplyKeyboardMarkup", "ReplyKeyboardRemove", "ForceReply"}}, "ReplyMarkup"},
+		{SpecField{Name: "photo", Types: []string{"PhotoSize"}}, "*PhotoSize"},
+		{SpecField{Name: "photos", Types: []string{"Array of Array of PhotoSize"}}, "[][]PhotoSize"},
+		{SpecField{Name: "is_anonymous", Types: []string{"Boolean"}, Description: "True, if the poll needs to be anonymous, defaults to True"}, "*bool"},
+		{SpecField{Name: "is_anonymous", Types: []string{"Boolean"}, Required: true, Description: "defaults to True"}, "bool"},
+		{SpecField{Name: "show_alert", Types: []string{"Boolean"}, Description: "Defaults to false."}, "bool"},
+		{SpecField{Name: "cache_time", Types: []string{"Integer"}, Description: "Defaults to 300."}, "*int"},
+		{SpecField{Name: "timeout", Types: []string{"Integer"}, Description: "Defaults to 0, i.e. usual short polling."}, "int"},
+		{SpecField{Name: "correct_option_id", Types: []string{"Integer"}, Description: "0-based identifier of the correct answer option"}, "*int"},
+		{SpecField{Name: "name", Types: []string{"String"}, Description: "Pass an empty string to remove the dedicated name."}, "*string"},
+		{SpecField{Name: "emoji", Types: []string{"String"}, Description: "Defaults to “🎲”"}, "string"},
+		{SpecField{Name: "switch_inline_query", Types: []string{"String"}, Description: "May be empty, in which case just the bot's username will be inserted."}, "*string"},
+		{SpecField{Name: "next_offset", Types: []string{"String"}, Description: "Pass an empty string if there are no more results."}, "string"},
+		{SpecField{Name: "chat_is_forum", Types: []string{"Boolean"}, Description: "Pass True to request a forum supergroup, pass False to request a non-forum chat."}, "*bool"},
+	} {
+		got, err := g.fieldType(tc.field)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got, tc.field.Name)
+	}
+
+	got, err := g.fieldType(SpecField{Name: "result", Types: []string{"MessageId"}})
+	require.NoError(t, err)
+	assert.Equal(t, "*MessageID", got)
+
+	_, err = g.fieldType(SpecField{Name: "x", Types: []string{"Missing"}})
+	assert.Error(t, err)
+}
+
+func TestUnionFieldTypes(t *testing.T) {
+	g := &generator{
+		spec: Spec{Types: map[string]SpecType{
+			"InputMedia":               {Subtypes: []string{"InputMediaPhoto", "InputMediaVideo"}},
+			"InputMediaPhoto":          {SubtypeOf: []string{"InputMedia"}},
+			"InputMediaVideo":          {SubtypeOf: []string{"InputMedia"}},
+			"InlineQueryResult":        {Subtypes: []string{"InlineQueryResultGame"}},
+			"InlineQueryResultGame":    {SubtypeOf: []string{"InlineQueryResult"}},
+			"Message":                  {},
+			"MaybeInaccessibleMessage": {Subtypes: []string{"Message"}},
+		}},
+		declared: declared{Types: map[string]bool{"InlineQueryResult": true}, Interfaces: map[string]bool{"InlineQueryResult": true}},
+	}
+
+	for _, tc := range []struct {
+		field SpecField
+		want  string
+	}{
+		{SpecField{Name: "media", Types: []string{"InputMedia"}}, "*InputMedia"},
+		{SpecField{Name: "media", Types: []string{"Array of InputMediaPhoto", "Array of InputMediaVideo"}}, "[]InputMedia"},
+		{SpecField{Name: "result", Types: []string{"InlineQueryResult"}}, "InlineQueryResult"},
+		{SpecField{Name: "pinned_message", Types: []string{"MaybeInaccessibleMessage"}}, "*Message"},
+	} {
+		got, err := g.fieldType(tc.field)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got, tc.field.Name)
+	}
+}
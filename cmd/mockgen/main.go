@@ -0,0 +1,361 @@
+// Command mockgen generates the botmock package from the interfaces of
+// gotelegrambot. It is run by go generate in the root package:
+//
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// config represents the flags of the command.
+type config struct {
+	In         string
+	Out        string
+	Interface  string
+	Type       string
+	ImportPath string
+}
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.In, "in", "interfaces.go", "file declaring the interfaces")
+	flag.StringVar(&cfg.Out, "out", "botmock/bot.go", "file to generate")
+	flag.StringVar(&cfg.Interface, "interface", "API", "interface to implement")
+	flag.StringVar(&cfg.Type, "type", "Bot", "name of the generated type")
+	flag.StringVar(&cfg.ImportPath, "import", "github.com/KazeDevID/gotelegrambot", "import path of the package declaring the interfaces")
+	flag.Parse()
+
+	src, err := generate(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(cfg.Out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// method represents an interface method.
+type method struct {
+	Name    string
+	Params  []param
+	Results []string
+}
+
+// param represents a method parameter.
+type param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+// generator holds the state of a generation.
+type generator struct {
+	cfg        config
+	interfaces map[string]*ast.InterfaceType
+	imports    map[string]string
+	used       map[string]bool
+}
+
+// generate returns the formatted source of the mock.
+func generate(cfg config) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, cfg.In, nil, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse interfaces")
+	}
+
+	g := &generator{
+		cfg:        cfg,
+		interfaces: make(map[string]*ast.InterfaceType),
+		imports:    make(map[string]string),
+		used:       map[string]bool{"sync": true, cfg.ImportPath: true},
+	}
+
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		g.imports[name] = path
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		if spec, ok := node.(*ast.TypeSpec); ok {
+			if iface, ok := spec.Type.(*ast.InterfaceType); ok {
+				g.interfaces[spec.Name.Name] = iface
+			}
+		}
+		return true
+	})
+
+	methods, err := g.methods(cfg.Interface, make(map[string]bool))
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	g.write(&buf, methods)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to format generated code:\n%s", buf.Bytes())
+	}
+	return src, nil
+}
+
+// methods collects the methods of an interface and the ones it embeds, in
+// declaration order.
+func (g *generator) methods(name string, seen map[string]bool) ([]method, error) {
+	iface, ok := g.interfaces[name]
+	if !ok {
+		return nil, errors.Errorf("interface %s not found", name)
+	}
+
+	var methods []method
+	for _, field := range iface.Methods.List {
+		if embedded, ok := field.Type.(*ast.Ident); ok {
+			inner, err := g.methods(embedded.Name, seen)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, inner...)
+			continue
+		}
+
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, errors.Errorf("unsupported member of interface %s", name)
+		}
+		if seen[field.Names[0].Name] {
+			continue
+		}
+		seen[field.Names[0].Name] = true
+
+		m, err := g.method(field.Names[0].Name, fn)
+		if err != nil {
+			return nil, err
+		}
+		methods = append(methods, m)
+	}
+	return methods, nil
+}
+
+func (g *generator) method(name string, fn *ast.FuncType) (method, error) {
+	m := method{Name: name}
+
+	for i, field := range fn.Params.List {
+		typ, err := g.typeString(field.Type)
+		if err != nil {
+			return m, errors.Wrapf(err, "method %s", name)
+		}
+		_, variadic := field.Type.(*ast.Ellipsis)
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, ident := range names {
+			m.Params = append(m.Params, param{Name: ident.Name, Type: typ, Variadic: variadic})
+		}
+	}
+
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			typ, err := g.typeString(field.Type)
+			if err != nil {
+				return m, errors.Wrapf(err, "method %s", name)
+			}
+			count := len(field.Names)
+			if count == 0 {
+				count = 1
+			}
+			for i := 0; i < count; i++ {
+				m.Results = append(m.Results, typ)
+			}
+		}
+	}
+	return m, nil
+}
+
+// typeString prints a type as seen from the generated package, qualifying the
+// types of the source package.
+func (g *generator) typeString(expr ast.Expr) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return g.qualifier() + "." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", errors.New("unsupported selector")
+		}
+		path, ok := g.imports[pkg.Name]
+		if !ok {
+			return "", errors.Errorf("unknown package %s", pkg.Name)
+		}
+		g.used[path] = true
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		inner, err := g.typeString(t.X)
+		return "*" + inner, err
+	case *ast.Ellipsis:
+		inner, err := g.typeString(t.Elt)
+		return "..." + inner, err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return "", errors.New("unsupported array type")
+		}
+		inner, err := g.typeString(t.Elt)
+		return "[]" + inner, err
+	case *ast.MapType:
+		key, err := g.typeString(t.Key)
+		if err != nil {
+			return "", err
+		}
+		value, err := g.typeString(t.Value)
+		return "map[" + key + "]" + value, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}", nil
+		}
+	}
+	return "", errors.Errorf("unsupported type %T", expr)
+}
+
+func (g *generator) qualifier() string {
+	return filepath.Base(g.cfg.ImportPath)
+}
+
+// zero returns the zero value of a result type, or "" when it has no literal.
+func zero(typ string) string {
+	switch {
+	case strings.HasPrefix(typ, "*"), strings.HasPrefix(typ, "[]"), strings.HasPrefix(typ, "map["),
+		typ == "interface{}", typ == "error":
+		return "nil"
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case strings.HasPrefix(typ, "int"), strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "float"):
+		return "0"
+	}
+	return ""
+}
+
+func (g *generator) write(buf *bytes.Buffer, methods []method) {
+	fmt.Fprintf(buf, "// Code generated by mockgen from %s. DO NOT EDIT.\n\n", filepath.Base(g.cfg.In))
+	fmt.Fprintf(buf, "package %s\n\n", filepath.Base(filepath.Dir(g.cfg.Out)))
+
+	// Standard library imports first, as goimports groups them
+	var std, others []string
+	for path := range g.used {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			others = append(others, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(others)
+	buf.WriteString("import (\n")
+	for _, path := range std {
+		fmt.Fprintf(buf, "%q\n", path)
+	}
+	buf.WriteString("\n")
+	for _, path := range others {
+		fmt.Fprintf(buf, "%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+
+	qualified := g.qualifier() + "." + g.cfg.Interface
+	fmt.Fprintf(buf, "// %s is a fake %s.\n", g.cfg.Type, qualified)
+	buf.WriteString("//\n// Each method calls the field named after it with a Func suffix, or returns\n")
+	buf.WriteString("// an *UnexpectedCallError when the field is nil. Calls are recorded either way.\n")
+	fmt.Fprintf(buf, "type %s struct {\n", g.cfg.Type)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "%sFunc func(%s) %s\n", m.Name, signature(m.Params), results(m.Results))
+	}
+	buf.WriteString("\nmu sync.Mutex\ncalls []Call\n}\n\n")
+	fmt.Fprintf(buf, "var _ %s = (*%s)(nil)\n", qualified, g.cfg.Type)
+
+	for _, m := range methods {
+		var args, recorded []string
+		for _, p := range m.Params {
+			arg := p.Name
+			if p.Variadic {
+				arg += "..."
+			}
+			args = append(args, arg)
+			if p.Type != "context.Context" {
+				recorded = append(recorded, p.Name)
+			}
+		}
+
+		fmt.Fprintf(buf, "\n// %s calls %sFunc.\n", m.Name, m.Name)
+		fmt.Fprintf(buf, "func (m *%s) %s(%s) %s {\n", g.cfg.Type, m.Name, signature(m.Params), results(m.Results))
+		fmt.Fprintf(buf, "m.record(%s)\n", strings.Join(append([]string{strconv.Quote(m.Name)}, recorded...), ", "))
+		fmt.Fprintf(buf, "if m.%sFunc == nil {\n", m.Name)
+
+		var zeros []string
+		for i, typ := range m.Results {
+			switch {
+			case typ == "error" && i == len(m.Results)-1:
+				zeros = append(zeros, fmt.Sprintf("&UnexpectedCallError{Method: %q}", m.Name))
+			case zero(typ) != "":
+				zeros = append(zeros, zero(typ))
+			default:
+				fmt.Fprintf(buf, "var r%d %s\n", i, typ)
+				zeros = append(zeros, fmt.Sprintf("r%d", i))
+			}
+		}
+		if len(zeros) > 0 {
+			fmt.Fprintf(buf, "return %s\n", strings.Join(zeros, ", "))
+		} else {
+			buf.WriteString("return\n")
+		}
+		buf.WriteString("}\n")
+
+		call := fmt.Sprintf("m.%sFunc(%s)", m.Name, strings.Join(args, ", "))
+		if len(m.Results) > 0 {
+			fmt.Fprintf(buf, "return %s\n}\n", call)
+		} else {
+			fmt.Fprintf(buf, "%s\n}\n", call)
+		}
+	}
+}
+
+func signature(params []param) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		parts[i] = p.Name + " " + p.Type
+	}
+	return strings.Join(parts, ", ")
+}
+
+func results(types []string) string {
+	switch len(types) {
+	case 0:
+		return ""
+	case 1:
+		return types[0]
+	}
+	return "(" + strings.Join(types, ", ") + ")"
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedMockUpToDate(t *testing.T) {
+	src, err := generate(config{
+		In:         "../../interfaces.go",
+		Out:        "../../botmock/bot.go",
+		Interface:  "API",
+		Type:       "Bot",
+		ImportPath: "github.com/KazeDevID/gotelegrambot",
+	})
+	require.NoError(t, err)
+
+	current, err := os.ReadFile("../../botmock/bot.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(current), string(src), "botmock is out of date, run go generate")
+}
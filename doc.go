@@ -44,6 +44,13 @@ To handle webhook updates:
 
 # Sending Messages
 
+Chats are identified by a ChatID, built from a numeric ID, a channel
+@username or a received message:
+
+	chatID := gotelegrambot.NewChatID(123456789)
+	channel := gotelegrambot.NewChatUsername("@mychannel")
+	chatID = update.Message.ChatID()
+
 To send a simple message:
 
 	_, err := bot.SendMessage(ctx, chatID, "Hello, world!")
@@ -54,12 +61,40 @@ With formatting and reply markup:
 		gotelegrambot.WithParseMode("Markdown"),
 		gotelegrambot.WithReplyMarkup(keyboard))
 
+Every Bot API method also has a params struct, such as SendPollParams, that
+the options fill in. Do calls the method of any params struct and returns its
+typed result. Optional fields whose zero value means something, like
+IsAnonymous, are pointers set with Ptr:
+
+	message, err := gotelegrambot.Do(ctx, bot, gotelegrambot.SendPollParams{
+		ChatID:      chatID,
+		Question:    "Lunch?",
+		Options:     []gotelegrambot.InputPollOption{{Text: "Pizza"}, {Text: "Sushi"}},
+		IsAnonymous: gotelegrambot.Ptr(false),
+	})
+
 # Handling Media
 
-To send a photo:
+To send a photo to a forum topic:
 
 	_, err := bot.SendPhoto(ctx, chatID, "https://example.com/image.jpg",
-		gotelegrambot.WithCaption("An example image"))
+		gotelegrambot.WithPhotoMessageThreadID(threadID))
+
+Local files are uploaded from a file:// path:
+
+	_, err := bot.SendDocument(ctx, gotelegrambot.SendDocumentParams{
+		ChatID:   chatID,
+		Document: "file:///path/to/file.pdf",
+		Caption:  "An example file",
+	})
+
+# Inline Mode
+
+To answer an inline query:
+
+	err := bot.AnswerInlineQuery(ctx, query.ID, []gotelegrambot.InlineQueryResult{
+		gotelegrambot.NewInlineQueryResultArticleText("1", "Hello", "Hello, world!"),
+	}, gotelegrambot.WithInlineCacheTime(60))
 
 # Error Handling
 
@@ -75,6 +110,53 @@ The library provides detailed error information from the Telegram API:
 		}
 	}
 
+API errors are classified into kinds that can be matched with errors.Is:
+
+	if errors.Is(err, gotelegrambot.ErrBotBlocked) {
+		// Stop messaging this user
+	}
+
+# Logging
+
+Logs are structured and have the bot token redacted. LogLevelTrace also logs
+request and response bodies:
+
+	bot, err := gotelegrambot.New(token,
+		gotelegrambot.WithLogger(gotelegrambot.NewLogger(os.Stderr, gotelegrambot.LogLevelInfo)))
+
+# Metrics
+
+API calls, retries, rate limit waits and update handling are reported to a
+Metrics implementation. PrometheusMetrics serves them in the Prometheus text
+format:
+
+	metrics := gotelegrambot.NewPrometheusMetrics()
+	bot, err := gotelegrambot.New(token, gotelegrambot.WithMetrics(metrics))
+	http.Handle("/metrics", metrics)
+
+# Generated Code
+
+The params struct of every method and every Bot API type are generated by
+cmd/botapigen from api/botapi.json, the Bot API 7.11 specification, into
+methods_gen.go and types_gen.go. Union types, such as ChatMember,
+MessageOrigin and BotCommandScope, are structs with one field per variant,
+decoded according to their type or status; each variant sets that field
+itself when encoded. The generator skips whatever is declared by hand:
+
+  - InlineQueryResult, InputMessageContent and ReplyMarkup, which are sealed
+    interfaces implemented by the generated types.
+  - File, which adds the download URL.
+
+# Testing
+
+The methods of Bot are grouped into small interfaces such as Sender, Editor,
+ChatAdmin and FileService, all embedded in API. Code depending on them can be
+unit tested with the generated fakes of the botmock package:
+
+	func Notify(ctx context.Context, sender gotelegrambot.Sender, chatID gotelegrambot.ChatID) error
+
+The telegramtest package provides a fake Bot API server for end-to-end tests.
+
 # Cancellation
 
 All API methods accept a context.Context, allowing for proper cancellation:
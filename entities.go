@@ -0,0 +1,432 @@
+package gotelegrambot
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Message entity types.
+const (
+	EntityTypeMention              = "mention"
+	EntityTypeHashtag              = "hashtag"
+	EntityTypeCashtag              = "cashtag"
+	EntityTypeBotCommand           = "bot_command"
+	EntityTypeURL                  = "url"
+	EntityTypeEmail                = "email"
+	EntityTypePhoneNumber          = "phone_number"
+	EntityTypeBold                 = "bold"
+	EntityTypeItalic               = "italic"
+	EntityTypeUnderline            = "underline"
+	EntityTypeStrikethrough        = "strikethrough"
+	EntityTypeSpoiler              = "spoiler"
+	EntityTypeBlockquote           = "blockquote"
+	EntityTypeExpandableBlockquote = "expandable_blockquote"
+	EntityTypeCode                 = "code"
+	EntityTypePre                  = "pre"
+	EntityTypeTextLink             = "text_link"
+	EntityTypeTextMention          = "text_mention"
+	EntityTypeCustomEmoji          = "custom_emoji"
+)
+
+// UTF16Len returns the length of s in UTF-16 code units, the unit Telegram
+// uses for entity offsets and message length limits.
+func UTF16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
+// EntityText returns the part of text covered by the entity.
+// Entity offsets and lengths are counted in UTF-16 code units, so the text
+// cannot be sliced directly when it contains characters outside the BMP.
+func EntityText(text string, entity MessageEntity) string {
+	units := utf16.Encode([]rune(text))
+	start, end := clampEntity(entity, len(units))
+	return string(utf16.Decode(units[start:end]))
+}
+
+// clampEntity returns the entity bounds limited to a text of n UTF-16 units.
+func clampEntity(entity MessageEntity, n int) (int, int) {
+	start := entity.Offset
+	if start < 0 {
+		start = 0
+	}
+	if start > n {
+		start = n
+	}
+	end := start + entity.Length
+	if end > n {
+		end = n
+	}
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+// textAndEntities returns the text or caption of the message together with its entities.
+func (m *Message) textAndEntities() (string, []MessageEntity) {
+	if m.Text != "" {
+		return m.Text, m.Entities
+	}
+	return m.Caption, m.CaptionEntities
+}
+
+// EntityValues returns the text of every entity of the given type in the
+// message text or caption, in order of appearance.
+func (m *Message) EntityValues(entityType string) []string {
+	text, entities := m.textAndEntities()
+
+	var values []string
+	for _, entity := range entities {
+		if entity.Type == entityType {
+			values = append(values, EntityText(text, entity))
+		}
+	}
+	return values
+}
+
+// URLs returns all links in the message, both plain URLs and the targets of text links.
+func (m *Message) URLs() []string {
+	text, entities := m.textAndEntities()
+
+	var urls []string
+	for _, entity := range entities {
+		switch entity.Type {
+		case EntityTypeURL:
+			urls = append(urls, EntityText(text, entity))
+		case EntityTypeTextLink:
+			urls = append(urls, entity.URL)
+		}
+	}
+	return urls
+}
+
+// Mentions returns all @username mentions in the message.
+func (m *Message) Mentions() []string {
+	return m.EntityValues(EntityTypeMention)
+}
+
+// MentionedUsers returns the users mentioned by name, for users without a username.
+func (m *Message) MentionedUsers() []*User {
+	_, entities := m.textAndEntities()
+
+	var users []*User
+	for _, entity := range entities {
+		if entity.Type == EntityTypeTextMention && entity.User != nil {
+			users = append(users, entity.User)
+		}
+	}
+	return users
+}
+
+// Hashtags returns all hashtags in the message.
+func (m *Message) Hashtags() []string {
+	return m.EntityValues(EntityTypeHashtag)
+}
+
+// Commands returns all bot commands in the message, such as "/start" or "/help@my_bot".
+func (m *Message) Commands() []string {
+	return m.EntityValues(EntityTypeBotCommand)
+}
+
+// HTML returns the message text or caption with its entities rendered as HTML.
+func (m *Message) HTML() string {
+	text, entities := m.textAndEntities()
+	return EntitiesToHTML(text, entities)
+}
+
+// MarkdownV2 returns the message text or caption with its entities rendered as MarkdownV2.
+func (m *Message) MarkdownV2() string {
+	text, entities := m.textAndEntities()
+	return EntitiesToMarkdownV2(text, entities)
+}
+
+// EntitiesToHTML renders text and its entities using the HTML parse mode.
+func EntitiesToHTML(text string, entities []MessageEntity) string {
+	return renderEntities(text, entities, htmlFormatter{})
+}
+
+// EntitiesToMarkdownV2 renders text and its entities using the MarkdownV2 parse mode.
+func EntitiesToMarkdownV2(text string, entities []MessageEntity) string {
+	return renderEntities(text, entities, markdownV2Formatter{})
+}
+
+// entityFormatter produces the markup for a parse mode.
+type entityFormatter interface {
+	open(entity MessageEntity) string
+	close(entity MessageEntity) string
+	escape(text string, open []MessageEntity) string
+
+	// separator returns what to write between the adjacent markups prev and next.
+	separator(prev, next string) string
+}
+
+// renderEntities walks text in UTF-16 units, emitting markup at entity boundaries.
+// Entities are expected to nest, which is what Telegram guarantees.
+func renderEntities(text string, entities []MessageEntity, f entityFormatter) string {
+	if len(entities) == 0 {
+		return f.escape(text, nil)
+	}
+
+	units := utf16.Encode([]rune(text))
+
+	sorted := make([]MessageEntity, len(entities))
+	copy(sorted, entities)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Offset != sorted[j].Offset {
+			return sorted[i].Offset < sorted[j].Offset
+		}
+		return sorted[i].Length > sorted[j].Length
+	})
+
+	var sb strings.Builder
+	var stack []MessageEntity
+	next := 0
+	pos := 0
+
+	// last is the markup written last, or "" after text
+	last := ""
+	writeMarkup := func(markup string) {
+		if markup == "" {
+			return
+		}
+		sb.WriteString(f.separator(last, markup))
+		sb.WriteString(markup)
+		last = markup
+	}
+
+	for {
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if _, end := clampEntity(top, len(units)); end > pos {
+				break
+			}
+			writeMarkup(f.close(top))
+			stack = stack[:len(stack)-1]
+		}
+
+		if pos >= len(units) && next >= len(sorted) {
+			break
+		}
+
+		for next < len(sorted) {
+			if start, _ := clampEntity(sorted[next], len(units)); start > pos {
+				break
+			}
+			writeMarkup(f.open(sorted[next]))
+			stack = append(stack, sorted[next])
+			next++
+		}
+
+		stop := len(units)
+		if next < len(sorted) {
+			if start, _ := clampEntity(sorted[next], len(units)); start < stop {
+				stop = start
+			}
+		}
+		for _, entity := range stack {
+			if _, end := clampEntity(entity, len(units)); end < stop {
+				stop = end
+			}
+		}
+
+		if stop > pos {
+			sb.WriteString(f.escape(string(utf16.Decode(units[pos:stop])), stack))
+			last = ""
+			pos = stop
+		}
+	}
+
+	return sb.String()
+}
+
+// htmlFormatter renders entities for the HTML parse mode.
+type htmlFormatter struct{}
+
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+func (htmlFormatter) open(entity MessageEntity) string {
+	switch entity.Type {
+	case EntityTypeBold:
+		return "<b>"
+	case EntityTypeItalic:
+		return "<i>"
+	case EntityTypeUnderline:
+		return "<u>"
+	case EntityTypeStrikethrough:
+		return "<s>"
+	case EntityTypeSpoiler:
+		return "<tg-spoiler>"
+	case EntityTypeCode:
+		return "<code>"
+	case EntityTypePre:
+		if entity.Language != "" {
+			return `<pre><code class="language-` + htmlEscaper.Replace(entity.Language) + `">`
+		}
+		return "<pre>"
+	case EntityTypeTextLink:
+		return `<a href="` + htmlEscaper.Replace(entity.URL) + `">`
+	case EntityTypeTextMention:
+		if entity.User != nil {
+			return `<a href="tg://user?id=` + strconv.FormatInt(entity.User.ID, 10) + `">`
+		}
+	case EntityTypeCustomEmoji:
+		return `<tg-emoji emoji-id="` + htmlEscaper.Replace(entity.CustomEmojiID) + `">`
+	case EntityTypeBlockquote:
+		return "<blockquote>"
+	case EntityTypeExpandableBlockquote:
+		return "<blockquote expandable>"
+	}
+	return ""
+}
+
+func (htmlFormatter) close(entity MessageEntity) string {
+	switch entity.Type {
+	case EntityTypeBold:
+		return "</b>"
+	case EntityTypeItalic:
+		return "</i>"
+	case EntityTypeUnderline:
+		return "</u>"
+	case EntityTypeStrikethrough:
+		return "</s>"
+	case EntityTypeSpoiler:
+		return "</tg-spoiler>"
+	case EntityTypeCode:
+		return "</code>"
+	case EntityTypePre:
+		if entity.Language != "" {
+			return "</code></pre>"
+		}
+		return "</pre>"
+	case EntityTypeTextLink:
+		return "</a>"
+	case EntityTypeTextMention:
+		if entity.User != nil {
+			return "</a>"
+		}
+	case EntityTypeCustomEmoji:
+		return "</tg-emoji>"
+	case EntityTypeBlockquote, EntityTypeExpandableBlockquote:
+		return "</blockquote>"
+	}
+	return ""
+}
+
+func (htmlFormatter) escape(text string, _ []MessageEntity) string {
+	return htmlEscaper.Replace(text)
+}
+
+func (htmlFormatter) separator(_, _ string) string {
+	return ""
+}
+
+// markdownV2Formatter renders entities for the MarkdownV2 parse mode.
+type markdownV2Formatter struct{}
+
+var (
+	markdownV2Escaper = strings.NewReplacer(
+		`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`,
+		"~", `\~`, "`", "\\`", ">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`,
+		"|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+	)
+	markdownV2CodeEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`")
+	markdownV2LinkEscaper = strings.NewReplacer(`\`, `\\`, ")", `\)`)
+)
+
+func (markdownV2Formatter) open(entity MessageEntity) string {
+	switch entity.Type {
+	case EntityTypeBold:
+		return "*"
+	case EntityTypeItalic:
+		return "_"
+	case EntityTypeUnderline:
+		return "__"
+	case EntityTypeStrikethrough:
+		return "~"
+	case EntityTypeSpoiler:
+		return "||"
+	case EntityTypeCode:
+		return "`"
+	case EntityTypePre:
+		return "```" + entity.Language + "\n"
+	case EntityTypeTextLink, EntityTypeTextMention:
+		return "["
+	case EntityTypeCustomEmoji:
+		return "!["
+	case EntityTypeBlockquote:
+		return ">"
+	case EntityTypeExpandableBlockquote:
+		return "**>"
+	}
+	return ""
+}
+
+func (markdownV2Formatter) close(entity MessageEntity) string {
+	switch entity.Type {
+	case EntityTypeBold:
+		return "*"
+	case EntityTypeItalic:
+		return "_"
+	case EntityTypeUnderline:
+		return "__"
+	case EntityTypeStrikethrough:
+		return "~"
+	case EntityTypeSpoiler:
+		return "||"
+	case EntityTypeCode:
+		return "`"
+	case EntityTypePre:
+		return "```"
+	case EntityTypeTextLink:
+		return "](" + markdownV2LinkEscaper.Replace(entity.URL) + ")"
+	case EntityTypeTextMention:
+		if entity.User != nil {
+			return "](tg://user?id=" + strconv.FormatInt(entity.User.ID, 10) + ")"
+		}
+		return "]()"
+	case EntityTypeCustomEmoji:
+		return "](tg://emoji?id=" + markdownV2LinkEscaper.Replace(entity.CustomEmojiID) + ")"
+	case EntityTypeExpandableBlockquote:
+		return "||"
+	}
+	return ""
+}
+
+// separator keeps an italic marker apart from a following underscore marker.
+// MarkdownV2 reads underscores greedily from left to right, so "_" followed by
+// "__" would close an underline instead of the italic; Telegram ignores the
+// carriage return it documents as separator.
+func (markdownV2Formatter) separator(prev, next string) string {
+	if prev == "_" && strings.HasPrefix(next, "_") {
+		return "\r"
+	}
+	return ""
+}
+
+func (markdownV2Formatter) escape(text string, open []MessageEntity) string {
+	quoted := false
+	for _, entity := range open {
+		switch entity.Type {
+		case EntityTypeCode, EntityTypePre:
+			return markdownV2CodeEscaper.Replace(text)
+		case EntityTypeBlockquote, EntityTypeExpandableBlockquote:
+			quoted = true
+		}
+	}
+
+	text = markdownV2Escaper.Replace(text)
+	if quoted {
+		text = strings.ReplaceAll(text, "\n", "\n>")
+	}
+	return text
+}
@@ -0,0 +1,83 @@
+package gotelegrambot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityText(t *testing.T) {
+	// "👋" is a surrogate pair, so everything after it is shifted by two UTF-16 units
+	text := "👋 Hi @gopher, see https://go.dev #golang"
+	assert.Equal(t, 41, UTF16Len(text))
+
+	msg := &Message{
+		Text: text,
+		Entities: []MessageEntity{
+			{Type: EntityTypeMention, Offset: 6, Length: 7},
+			{Type: EntityTypeURL, Offset: 19, Length: 14},
+			{Type: EntityTypeHashtag, Offset: 34, Length: 7},
+		},
+	}
+
+	assert.Equal(t, []string{"@gopher"}, msg.Mentions())
+	assert.Equal(t, []string{"https://go.dev"}, msg.URLs())
+	assert.Equal(t, []string{"#golang"}, msg.Hashtags())
+	assert.Empty(t, msg.Commands())
+
+	// Out of range entities are clamped instead of panicking
+	assert.Equal(t, "", EntityText("abc", MessageEntity{Offset: 5, Length: 2}))
+	assert.Equal(t, "bc", EntityText("abc", MessageEntity{Offset: 1, Length: 10}))
+}
+
+func TestEntitiesToHTML(t *testing.T) {
+	text := "😀 bold <italic> link"
+	entities := []MessageEntity{
+		{Type: EntityTypeBold, Offset: 3, Length: 13},
+		{Type: EntityTypeItalic, Offset: 8, Length: 8},
+		{Type: EntityTypeTextLink, Offset: 17, Length: 4, URL: "https://example.com/?a=1&b=2"},
+	}
+
+	assert.Equal(t,
+		`😀 <b>bold <i>&lt;italic&gt;</i></b> <a href="https://example.com/?a=1&amp;b=2">link</a>`,
+		EntitiesToHTML(text, entities))
+
+	msg := &Message{Caption: text, CaptionEntities: entities}
+	assert.Equal(t, EntitiesToHTML(text, entities), msg.HTML())
+}
+
+func TestEntitiesToMarkdownV2(t *testing.T) {
+	text := "Price: 1.5! code_x\nquote"
+	entities := []MessageEntity{
+		{Type: EntityTypeBold, Offset: 0, Length: 5},
+		{Type: EntityTypeCode, Offset: 12, Length: 6},
+		{Type: EntityTypeBlockquote, Offset: 19, Length: 5},
+	}
+
+	assert.Equal(t, "*Price*: 1\\.5\\! `code_x`\n>quote", EntitiesToMarkdownV2(text, entities))
+	assert.Equal(t, "plain \\_text\\_", EntitiesToMarkdownV2("plain _text_", nil))
+}
+
+func TestEntitiesToMarkdownV2Italic(t *testing.T) {
+	assert.Equal(t, "an _italic_ word", EntitiesToMarkdownV2("an italic word", []MessageEntity{
+		{Type: EntityTypeItalic, Offset: 3, Length: 6},
+	}))
+
+	// Italic inside underline: the italic end is separated from the underline end
+	assert.Equal(t, "___both_\r__ end", EntitiesToMarkdownV2("both end", []MessageEntity{
+		{Type: EntityTypeUnderline, Offset: 0, Length: 4},
+		{Type: EntityTypeItalic, Offset: 0, Length: 4},
+	}))
+
+	// Underline inside italic: the italic start is separated from the underline start
+	assert.Equal(t, "_\r__both___ end", EntitiesToMarkdownV2("both end", []MessageEntity{
+		{Type: EntityTypeItalic, Offset: 0, Length: 4},
+		{Type: EntityTypeUnderline, Offset: 0, Length: 4},
+	}))
+
+	// Adjacent italic entities
+	assert.Equal(t, "_a_\r_b_", EntitiesToMarkdownV2("ab", []MessageEntity{
+		{Type: EntityTypeItalic, Offset: 0, Length: 1},
+		{Type: EntityTypeItalic, Offset: 1, Length: 1},
+	}))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -17,25 +18,191 @@ type APIResponse struct {
 	Parameters  *ResponseParameters `json:"parameters,omitempty"`
 }
 
-// ResponseParameters contains information about why a request was unsuccessful.
-type ResponseParameters struct {
-	MigrateToChatID int64 `json:"migrate_to_chat_id,omitempty"`
-	RetryAfter      int   `json:"retry_after,omitempty"`
-}
-
 // Error represents an error from the Telegram API.
 type Error struct {
 	Code        int
 	Message     string
+	Kind        ErrorKind
+	Method      string
+	Params      map[string]interface{}
 	Parameters  *ResponseParameters
 	Response    *http.Response
 }
 
 // Error returns a string representation of the error.
 func (e *Error) Error() string {
+	if e.Method != "" {
+		return fmt.Sprintf("telegram: %s: %d %s", e.Method, e.Code, e.Message)
+	}
 	return fmt.Sprintf("telegram: %d %s", e.Code, e.Message)
 }
 
+// Is reports whether the error is of the given kind, so that
+// errors.Is(err, ErrBotBlocked) works on wrapped API errors. Errors also match
+// the kind of their HTTP status, e.g. ErrBotBlocked matches ErrForbidden too.
+func (e *Error) Is(target error) bool {
+	kind, ok := target.(ErrorKind)
+	if !ok || kind == ErrUnknown {
+		return false
+	}
+	return kind == e.Kind || kind == classifyError(e.Code, "", nil)
+}
+
+// ErrorKind classifies an API error. The kinds are usable as sentinel errors
+// with errors.Is.
+type ErrorKind int
+
+// Kinds of API errors.
+const (
+	ErrUnknown ErrorKind = iota
+	ErrBadRequest
+	ErrUnauthorized
+	ErrForbidden
+	ErrNotFound
+	ErrConflict
+	ErrTooManyRequests
+	ErrServerError
+	ErrBotBlocked
+	ErrBotKicked
+	ErrUserDeactivated
+	ErrChatNotFound
+	ErrUserNotFound
+	ErrChatMigrated
+	ErrNotEnoughRights
+	ErrMessageNotModified
+	ErrMessageToEditNotFound
+	ErrMessageToDeleteNotFound
+	ErrMessageCantBeEdited
+	ErrMessageCantBeDeleted
+	ErrMessageTextEmpty
+	ErrMessageTooLong
+	ErrEntityParseError
+	ErrFileTooBig
+	ErrInvalidFileID
+	ErrQueryTooOld
+)
+
+var errorKindNames = map[ErrorKind]string{
+	ErrUnknown:                 "unknown error",
+	ErrBadRequest:              "bad request",
+	ErrUnauthorized:            "unauthorized",
+	ErrForbidden:               "forbidden",
+	ErrNotFound:                "not found",
+	ErrConflict:                "conflict",
+	ErrTooManyRequests:         "too many requests",
+	ErrServerError:             "server error",
+	ErrBotBlocked:              "bot was blocked by the user",
+	ErrBotKicked:               "bot was kicked from the chat",
+	ErrUserDeactivated:         "user is deactivated",
+	ErrChatNotFound:            "chat not found",
+	ErrUserNotFound:            "user not found",
+	ErrChatMigrated:            "group chat was migrated to a supergroup",
+	ErrNotEnoughRights:         "not enough rights",
+	ErrMessageNotModified:      "message is not modified",
+	ErrMessageToEditNotFound:   "message to edit not found",
+	ErrMessageToDeleteNotFound: "message to delete not found",
+	ErrMessageCantBeEdited:     "message can't be edited",
+	ErrMessageCantBeDeleted:    "message can't be deleted",
+	ErrMessageTextEmpty:        "message text is empty",
+	ErrMessageTooLong:          "message is too long",
+	ErrEntityParseError:        "can't parse entities",
+	ErrFileTooBig:              "file is too big",
+	ErrInvalidFileID:           "wrong file identifier",
+	ErrQueryTooOld:             "query is too old",
+}
+
+// Error returns the description of the kind.
+func (k ErrorKind) Error() string {
+	if name, ok := errorKindNames[k]; ok {
+		return "telegram: " + name
+	}
+	return "telegram: unknown error"
+}
+
+// errorDescriptions maps description fragments to the kind they identify.
+// They are checked in order, so more specific fragments come first.
+var errorDescriptions = []struct {
+	fragment string
+	kind     ErrorKind
+}{
+	{"bot was blocked by the user", ErrBotBlocked},
+	{"bot was kicked", ErrBotKicked},
+	{"bot is not a member", ErrBotKicked},
+	{"user is deactivated", ErrUserDeactivated},
+	{"chat not found", ErrChatNotFound},
+	{"user not found", ErrUserNotFound},
+	{"upgraded to a supergroup", ErrChatMigrated},
+	{"not enough rights", ErrNotEnoughRights},
+	{"message is not modified", ErrMessageNotModified},
+	{"message to edit not found", ErrMessageToEditNotFound},
+	{"message to delete not found", ErrMessageToDeleteNotFound},
+	{"message can't be edited", ErrMessageCantBeEdited},
+	{"message can't be deleted", ErrMessageCantBeDeleted},
+	{"message text is empty", ErrMessageTextEmpty},
+	{"message is too long", ErrMessageTooLong},
+	{"can't parse entities", ErrEntityParseError},
+	{"file is too big", ErrFileTooBig},
+	{"wrong file identifier", ErrInvalidFileID},
+	{"query is too old", ErrQueryTooOld},
+}
+
+// classifyError determines the kind of an API error from its code and description.
+func classifyError(code int, description string, parameters *ResponseParameters) ErrorKind {
+	if parameters != nil && parameters.MigrateToChatID != 0 {
+		return ErrChatMigrated
+	}
+
+	lower := strings.ToLower(description)
+	for _, d := range errorDescriptions {
+		if strings.Contains(lower, d.fragment) {
+			return d.kind
+		}
+	}
+
+	switch {
+	case code == http.StatusBadRequest:
+		return ErrBadRequest
+	case code == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case code == http.StatusForbidden:
+		return ErrForbidden
+	case code == http.StatusNotFound:
+		return ErrNotFound
+	case code == http.StatusConflict:
+		return ErrConflict
+	case code == http.StatusRequestEntityTooLarge:
+		return ErrFileTooBig
+	case code == http.StatusTooManyRequests:
+		return ErrTooManyRequests
+	case code >= 500 && code < 600:
+		return ErrServerError
+	}
+	return ErrUnknown
+}
+
+// redactedParams lists the params that carry secrets.
+var redactedParams = map[string]bool{
+	"secret_token":   true,
+	"provider_token": true,
+}
+
+// redactParams returns a copy of the request params with secrets replaced.
+func redactParams(params interface{}) map[string]interface{} {
+	values, ok := params.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	redacted := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		if redactedParams[key] {
+			value = "[REDACTED]"
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
+
 // ParseAPIResponse parses an API response into a generic struct.
 func ParseAPIResponse(data []byte, target interface{}) error {
 	var resp APIResponse
@@ -47,6 +214,7 @@ func ParseAPIResponse(data []byte, target interface{}) error {
 		return &Error{
 			Code:       resp.ErrorCode,
 			Message:    resp.Description,
+			Kind:       classifyError(resp.ErrorCode, resp.Description, resp.Parameters),
 			Parameters: resp.Parameters,
 		}
 	}
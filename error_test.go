@@ -0,0 +1,48 @@
+package gotelegrambot
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorKinds(t *testing.T) {
+	tests := []struct {
+		response string
+		kind     ErrorKind
+	}{
+		{`{"ok":false,"error_code":403,"description":"Forbidden: bot was blocked by the user"}`, ErrBotBlocked},
+		{`{"ok":false,"error_code":400,"description":"Bad Request: chat not found"}`, ErrChatNotFound},
+		{`{"ok":false,"error_code":400,"description":"Bad Request: message is not modified: specified new message content and reply markup are exactly the same"}`, ErrMessageNotModified},
+		{`{"ok":false,"error_code":400,"description":"Bad Request: can't parse entities: Unsupported start tag"}`, ErrEntityParseError},
+		{`{"ok":false,"error_code":401,"description":"Unauthorized"}`, ErrUnauthorized},
+		{`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 5","parameters":{"retry_after":5}}`, ErrTooManyRequests},
+		{`{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-100123}}`, ErrChatMigrated},
+		{`{"ok":false,"error_code":400,"description":"Bad Request: something new"}`, ErrBadRequest},
+	}
+
+	for _, tt := range tests {
+		err := errors.Wrap(ParseAPIResponse([]byte(tt.response), nil), "send failed")
+		assert.True(t, errors.Is(err, tt.kind), tt.response)
+	}
+
+	err := ParseAPIResponse([]byte(tests[0].response), nil)
+	assert.True(t, errors.Is(err, ErrForbidden))
+	assert.False(t, errors.Is(err, ErrChatNotFound))
+	assert.False(t, errors.Is(err, ErrUnknown))
+}
+
+func TestRedactParams(t *testing.T) {
+	params := map[string]interface{}{
+		"url":          "https://example.com/hook",
+		"secret_token": "s3cret",
+	}
+
+	redacted := redactParams(params)
+	require.NotNil(t, redacted)
+	assert.Equal(t, "[REDACTED]", redacted["secret_token"])
+	assert.Equal(t, "https://example.com/hook", redacted["url"])
+	assert.Equal(t, "s3cret", params["secret_token"])
+}
@@ -0,0 +1,155 @@
+package gotelegrambot
+
+import (
+	"context"
+)
+
+// Colors allowed for the icon of a forum topic created by a bot.
+const (
+	ForumTopicIconColorBlue   = 0x6FB9F0
+	ForumTopicIconColorYellow = 0xFFD67E
+	ForumTopicIconColorViolet = 0xCB86DB
+	ForumTopicIconColorGreen  = 0x8EEE98
+	ForumTopicIconColorRose   = 0xFF93B2
+	ForumTopicIconColorRed    = 0xFB6F5F
+)
+
+// TopicID returns the forum topic the message belongs to, or 0 when it was
+// not sent to a topic.
+func (m *Message) TopicID() int {
+	if !m.IsTopicMessage {
+		return 0
+	}
+	return m.MessageThreadID
+}
+
+// TopicName returns the name of the forum topic the message belongs to when
+// it is known. Telegram includes the topic's creation message as the reply
+// target of messages sent to a topic, which is where the name is taken from.
+func (m *Message) TopicName() string {
+	if m.ForumTopicCreated != nil {
+		return m.ForumTopicCreated.Name
+	}
+	if m.IsTopicMessage && m.ReplyToMessage != nil && m.ReplyToMessage.ForumTopicCreated != nil {
+		return m.ReplyToMessage.ForumTopicCreated.Name
+	}
+	return ""
+}
+
+// CreateForumTopic creates a topic in a forum supergroup chat.
+func (b *Bot) CreateForumTopic(ctx context.Context, chatID ChatID, name string, options ...CreateForumTopicOption) (*ForumTopic, error) {
+	params := CreateForumTopicParams{
+		ChatID: chatID,
+		Name:   name,
+	}
+	for _, opt := range options {
+		opt(&params)
+	}
+
+	return params.do(ctx, b)
+}
+
+// CreateForumTopicOption is a function that configures CreateForumTopic options.
+type CreateForumTopicOption func(*CreateForumTopicParams)
+
+// WithTopicIconColor sets the color of the topic icon. Use one of the ForumTopicIconColor constants.
+func WithTopicIconColor(color int) CreateForumTopicOption {
+	return func(p *CreateForumTopicParams) {
+		p.IconColor = color
+	}
+}
+
+// WithTopicIconCustomEmojiID sets the custom emoji shown as the topic icon.
+func WithTopicIconCustomEmojiID(customEmojiID string) CreateForumTopicOption {
+	return func(p *CreateForumTopicParams) {
+		p.IconCustomEmojiID = customEmojiID
+	}
+}
+
+// EditForumTopic edits the name and icon of a topic in a forum supergroup chat.
+// What isn't set by the options is left unchanged.
+func (b *Bot) EditForumTopic(ctx context.Context, chatID ChatID, messageThreadID int, options ...EditForumTopicOption) error {
+	params := EditForumTopicParams{
+		ChatID:          chatID,
+		MessageThreadID: messageThreadID,
+	}
+	for _, opt := range options {
+		opt(&params)
+	}
+
+	return params.do(ctx, b)
+}
+
+// EditForumTopicOption is a function that configures EditForumTopic options.
+type EditForumTopicOption func(*EditForumTopicParams)
+
+// WithTopicName sets the new name of the topic.
+func WithTopicName(name string) EditForumTopicOption {
+	return func(p *EditForumTopicParams) {
+		p.Name = name
+	}
+}
+
+// WithTopicIcon sets the new custom emoji of the topic icon.
+// An empty string removes the icon.
+func WithTopicIcon(customEmojiID string) EditForumTopicOption {
+	return func(p *EditForumTopicParams) {
+		p.IconCustomEmojiID = &customEmojiID
+	}
+}
+
+// CloseForumTopic closes an open topic in a forum supergroup chat.
+func (b *Bot) CloseForumTopic(ctx context.Context, chatID ChatID, messageThreadID int) error {
+	return CloseForumTopicParams{ChatID: chatID, MessageThreadID: messageThreadID}.do(ctx, b)
+}
+
+// ReopenForumTopic reopens a closed topic in a forum supergroup chat.
+func (b *Bot) ReopenForumTopic(ctx context.Context, chatID ChatID, messageThreadID int) error {
+	return ReopenForumTopicParams{ChatID: chatID, MessageThreadID: messageThreadID}.do(ctx, b)
+}
+
+// DeleteForumTopic deletes a forum topic along with all its messages.
+func (b *Bot) DeleteForumTopic(ctx context.Context, chatID ChatID, messageThreadID int) error {
+	return DeleteForumTopicParams{ChatID: chatID, MessageThreadID: messageThreadID}.do(ctx, b)
+}
+
+// UnpinAllForumTopicMessages clears the list of pinned messages in a forum topic.
+func (b *Bot) UnpinAllForumTopicMessages(ctx context.Context, chatID ChatID, messageThreadID int) error {
+	return UnpinAllForumTopicMessagesParams{ChatID: chatID, MessageThreadID: messageThreadID}.do(ctx, b)
+}
+
+// EditGeneralForumTopic changes the name of the General topic in a forum supergroup chat.
+func (b *Bot) EditGeneralForumTopic(ctx context.Context, chatID ChatID, name string) error {
+	return EditGeneralForumTopicParams{ChatID: chatID, Name: name}.do(ctx, b)
+}
+
+// CloseGeneralForumTopic closes the General topic in a forum supergroup chat.
+func (b *Bot) CloseGeneralForumTopic(ctx context.Context, chatID ChatID) error {
+	return CloseGeneralForumTopicParams{ChatID: chatID}.do(ctx, b)
+}
+
+// ReopenGeneralForumTopic reopens the General topic in a forum supergroup chat.
+func (b *Bot) ReopenGeneralForumTopic(ctx context.Context, chatID ChatID) error {
+	return ReopenGeneralForumTopicParams{ChatID: chatID}.do(ctx, b)
+}
+
+// HideGeneralForumTopic hides the General topic in a forum supergroup chat.
+// The topic is closed as well if it was open.
+func (b *Bot) HideGeneralForumTopic(ctx context.Context, chatID ChatID) error {
+	return HideGeneralForumTopicParams{ChatID: chatID}.do(ctx, b)
+}
+
+// UnhideGeneralForumTopic unhides the General topic in a forum supergroup chat.
+func (b *Bot) UnhideGeneralForumTopic(ctx context.Context, chatID ChatID) error {
+	return UnhideGeneralForumTopicParams{ChatID: chatID}.do(ctx, b)
+}
+
+// UnpinAllGeneralForumTopicMessages clears the list of pinned messages in the General topic.
+func (b *Bot) UnpinAllGeneralForumTopicMessages(ctx context.Context, chatID ChatID) error {
+	return UnpinAllGeneralForumTopicMessagesParams{ChatID: chatID}.do(ctx, b)
+}
+
+// GetForumTopicIconStickers gets the custom emoji stickers any user can use as a forum topic icon.
+func (b *Bot) GetForumTopicIconStickers(ctx context.Context) ([]Sticker, error) {
+	return GetForumTopicIconStickersParams{}.do(ctx, b)
+}
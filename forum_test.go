@@ -0,0 +1,132 @@
+package gotelegrambot_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/KazeDevID/gotelegrambot/telegramtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageTopic(t *testing.T) {
+	created := &gotelegrambot.Message{
+		MessageID:         7,
+		MessageThreadID:   7,
+		ForumTopicCreated: &gotelegrambot.ForumTopicCreated{Name: "Support"},
+	}
+	assert.Equal(t, "Support", created.TopicName())
+
+	message := &gotelegrambot.Message{MessageID: 9, MessageThreadID: 7, IsTopicMessage: true, ReplyToMessage: created}
+	assert.Equal(t, 7, message.TopicID())
+	assert.Equal(t, "Support", message.TopicName())
+
+	// A reply to a message outside of topics also carries a thread ID
+	reply := &gotelegrambot.Message{MessageID: 10, MessageThreadID: 3, ReplyToMessage: &gotelegrambot.Message{MessageID: 3}}
+	assert.Equal(t, 0, reply.TopicID())
+	assert.Equal(t, "", reply.TopicName())
+
+	// The topic creation message isn't included for replies to other messages
+	nested := &gotelegrambot.Message{MessageID: 11, MessageThreadID: 7, IsTopicMessage: true, ReplyToMessage: message}
+	assert.Equal(t, 7, nested.TopicID())
+	assert.Equal(t, "", nested.TopicName())
+}
+
+func TestSendToTopic(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	ctx := context.Background()
+	forum := telegramtest.NewGroupChat(-100123, "Forum")
+	forum.IsForum = true
+	h.Server.AddChat(forum)
+	chatID := forum.ChatID()
+
+	original := telegramtest.NewTextMessage(telegramtest.NewUser(42, "Alice"), forum, "hello").Message()
+	h.Server.AddMessage(original)
+
+	sent, err := h.Bot.SendMessage(ctx, chatID, "text", gotelegrambot.WithMessageThreadID(7))
+	require.NoError(t, err)
+	assert.Equal(t, 7, sent.MessageThreadID)
+
+	_, err = h.Bot.SendPhoto(ctx, chatID, "photo-file-id", gotelegrambot.WithPhotoMessageThreadID(7))
+	require.NoError(t, err)
+	_, err = h.Bot.SendPoll(ctx, chatID, "Lunch?", []string{"Pizza", "Sushi"}, gotelegrambot.WithPollMessageThreadID(7))
+	require.NoError(t, err)
+	_, err = h.Bot.SendInvoice(ctx, chatID, "Plan", "Monthly plan", "plan-1", "", "XTR",
+		[]gotelegrambot.LabeledPrice{{Label: "Plan", Amount: 100}}, gotelegrambot.WithInvoiceMessageThreadID(7))
+	require.NoError(t, err)
+	require.NoError(t, h.Bot.SendChatAction(ctx, chatID, "typing", gotelegrambot.WithChatActionMessageThreadID(7)))
+	_, err = h.Bot.ForwardMessage(ctx, chatID, chatID, original.MessageID, gotelegrambot.WithForwardMessageThreadID(7))
+	require.NoError(t, err)
+	_, err = h.Bot.CopyMessage(ctx, chatID, chatID, original.MessageID, gotelegrambot.WithCopyMessageThreadID(7))
+	require.NoError(t, err)
+
+	methods := []string{"sendMessage", "sendPhoto", "sendPoll", "sendInvoice", "sendChatAction", "forwardMessage", "copyMessage"}
+	h.ExpectCalls(methods...)
+	for _, method := range methods {
+		h.ExpectCall(method).Param("chat_id", -100123).Param("message_thread_id", 7)
+	}
+
+	// Without the option, messages go to the General topic
+	_, err = h.Bot.SendMessage(ctx, chatID, "text")
+	require.NoError(t, err)
+	h.ExpectCall("sendMessage").NoParam("message_thread_id")
+}
+
+func TestSendPhotoUpload(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	ctx := context.Background()
+	h.Server.AddChat(telegramtest.NewGroupChat(-100123, "Forum"))
+	chatID := gotelegrambot.NewChatID(-100123)
+
+	photoPath := filepath.Join(t.TempDir(), "cat.jpg")
+	require.NoError(t, os.WriteFile(photoPath, []byte("jpeg data"), 0o600))
+
+	_, err := h.Bot.SendPhoto(ctx, chatID, "file://"+photoPath, gotelegrambot.WithPhotoMessageThreadID(7))
+	require.NoError(t, err)
+	call := h.ExpectCall("sendPhoto").
+		Param("chat_id", -100123).
+		Param("message_thread_id", 7).
+		Param("photo", "attach://photo").
+		Call()
+	assert.Equal(t, []byte("jpeg data"), call.Files["photo"])
+
+	_, err = h.Bot.SendPhoto(ctx, chatID, "https://example.com/cat.jpg")
+	require.NoError(t, err)
+	call = h.ExpectCall("sendPhoto").
+		Param("photo", "https://example.com/cat.jpg").
+		NoParam("message_thread_id").
+		Call()
+	assert.Empty(t, call.Files)
+}
+
+func TestForumTopics(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	ctx := context.Background()
+	chatID := gotelegrambot.NewChatID(-100123)
+
+	h.Server.Handle("createForumTopic", func(call telegramtest.Call) (interface{}, error) {
+		return gotelegrambot.ForumTopic{
+			MessageThreadID: 7,
+			Name:            call.String("name"),
+			IconColor:       int(call.Int64("icon_color")),
+		}, nil
+	})
+
+	topic, err := h.Bot.CreateForumTopic(ctx, chatID, "Support", gotelegrambot.WithTopicIconColor(gotelegrambot.ForumTopicIconColorBlue))
+	require.NoError(t, err)
+	assert.Equal(t, gotelegrambot.ForumTopic{MessageThreadID: 7, Name: "Support", IconColor: gotelegrambot.ForumTopicIconColorBlue}, *topic)
+
+	require.NoError(t, h.Bot.EditForumTopic(ctx, chatID, topic.MessageThreadID, gotelegrambot.WithTopicIcon("")))
+	require.NoError(t, h.Bot.CloseForumTopic(ctx, chatID, topic.MessageThreadID))
+
+	h.ExpectCalls("createForumTopic", "editForumTopic", "closeForumTopic")
+	h.ExpectCall("createForumTopic").NoParam("icon_custom_emoji_id")
+	h.ExpectCall("editForumTopic").
+		Param("message_thread_id", 7).
+		Param("icon_custom_emoji_id", "").
+		NoParam("name")
+	h.ExpectCall("closeForumTopic").Param("message_thread_id", 7)
+}
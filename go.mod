@@ -1,6 +1,6 @@
 module github.com/KazeDevID/gotelegrambot
 
-go 1.20
+go 1.21
 
 require (
 	github.com/pkg/errors v0.9.1
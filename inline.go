@@ -0,0 +1,285 @@
+package gotelegrambot
+
+import (
+	"context"
+)
+
+// InlineQueryResult represents one result of an inline query. The type field
+// of each result is set when it is encoded.
+type InlineQueryResult interface {
+	inlineQueryResult()
+}
+
+// InputMessageContent represents the content of a message to be sent as a result of an inline query.
+type InputMessageContent interface {
+	inputMessageContent()
+}
+
+// AnswerInlineQuery sends answers to an inline query. No more than 50 results per query are allowed.
+func (b *Bot) AnswerInlineQuery(ctx context.Context, inlineQueryID string, results []InlineQueryResult, options ...AnswerInlineQueryOption) error {
+	if results == nil {
+		results = []InlineQueryResult{}
+	}
+
+	params := AnswerInlineQueryParams{
+		InlineQueryID: inlineQueryID,
+		Results:       results,
+	}
+	for _, opt := range options {
+		opt(&params)
+	}
+
+	return params.do(ctx, b)
+}
+
+// AnswerInlineQueryOption is a function that configures AnswerInlineQuery options.
+type AnswerInlineQueryOption func(*AnswerInlineQueryParams)
+
+// WithInlineCacheTime sets the maximum time in seconds the results may be cached on the server.
+func WithInlineCacheTime(cacheTime int) AnswerInlineQueryOption {
+	return func(p *AnswerInlineQueryParams) {
+		p.CacheTime = &cacheTime
+	}
+}
+
+// WithIsPersonal makes the results cached only for the user that sent the query.
+func WithIsPersonal(isPersonal bool) AnswerInlineQueryOption {
+	return func(p *AnswerInlineQueryParams) {
+		p.IsPersonal = isPersonal
+	}
+}
+
+// WithNextOffset sets the offset the client sends in the next query to receive more results.
+func WithNextOffset(nextOffset string) AnswerInlineQueryOption {
+	return func(p *AnswerInlineQueryParams) {
+		p.NextOffset = nextOffset
+	}
+}
+
+// WithInlineQueryButton sets the button shown above the inline query results.
+func WithInlineQueryButton(button *InlineQueryResultsButton) AnswerInlineQueryOption {
+	return func(p *AnswerInlineQueryParams) {
+		p.Button = button
+	}
+}
+
+// WithSwitchPrivateChat shows a button above the results that switches the user
+// to a private chat with the bot and sends it /start with the given parameter.
+func WithSwitchPrivateChat(text, startParameter string) AnswerInlineQueryOption {
+	return WithInlineQueryButton(&InlineQueryResultsButton{
+		Text:           text,
+		StartParameter: startParameter,
+	})
+}
+
+func (*InlineQueryResultArticle) inlineQueryResult()        {}
+func (*InlineQueryResultPhoto) inlineQueryResult()          {}
+func (*InlineQueryResultGif) inlineQueryResult()            {}
+func (*InlineQueryResultMpeg4Gif) inlineQueryResult()       {}
+func (*InlineQueryResultVideo) inlineQueryResult()          {}
+func (*InlineQueryResultAudio) inlineQueryResult()          {}
+func (*InlineQueryResultVoice) inlineQueryResult()          {}
+func (*InlineQueryResultDocument) inlineQueryResult()       {}
+func (*InlineQueryResultLocation) inlineQueryResult()       {}
+func (*InlineQueryResultVenue) inlineQueryResult()          {}
+func (*InlineQueryResultContact) inlineQueryResult()        {}
+func (*InlineQueryResultGame) inlineQueryResult()           {}
+func (*InlineQueryResultCachedPhoto) inlineQueryResult()    {}
+func (*InlineQueryResultCachedGif) inlineQueryResult()      {}
+func (*InlineQueryResultCachedMpeg4Gif) inlineQueryResult() {}
+func (*InlineQueryResultCachedSticker) inlineQueryResult()  {}
+func (*InlineQueryResultCachedDocument) inlineQueryResult() {}
+func (*InlineQueryResultCachedVideo) inlineQueryResult()    {}
+func (*InlineQueryResultCachedVoice) inlineQueryResult()    {}
+func (*InlineQueryResultCachedAudio) inlineQueryResult()    {}
+
+// NewInlineQueryResultArticle creates an article result that sends the given content.
+func NewInlineQueryResultArticle(id, title string, content InputMessageContent) *InlineQueryResultArticle {
+	return &InlineQueryResultArticle{
+		ID:                  id,
+		Title:               title,
+		InputMessageContent: content,
+	}
+}
+
+// NewInlineQueryResultArticleText creates an article result that sends a text message.
+func NewInlineQueryResultArticleText(id, title, text string) *InlineQueryResultArticle {
+	return NewInlineQueryResultArticle(id, title, &InputTextMessageContent{MessageText: text})
+}
+
+// NewInlineQueryResultPhoto creates a photo result.
+func NewInlineQueryResultPhoto(id, photoURL, thumbnailURL string) *InlineQueryResultPhoto {
+	return &InlineQueryResultPhoto{
+		ID:           id,
+		PhotoURL:     photoURL,
+		ThumbnailURL: thumbnailURL,
+	}
+}
+
+// NewInlineQueryResultGif creates a GIF result.
+func NewInlineQueryResultGif(id, gifURL, thumbnailURL string) *InlineQueryResultGif {
+	return &InlineQueryResultGif{
+		ID:           id,
+		GifURL:       gifURL,
+		ThumbnailURL: thumbnailURL,
+	}
+}
+
+// NewInlineQueryResultMpeg4Gif creates an MPEG-4 animation result.
+func NewInlineQueryResultMpeg4Gif(id, mpeg4URL, thumbnailURL string) *InlineQueryResultMpeg4Gif {
+	return &InlineQueryResultMpeg4Gif{
+		ID:           id,
+		Mpeg4URL:     mpeg4URL,
+		ThumbnailURL: thumbnailURL,
+	}
+}
+
+// NewInlineQueryResultVideo creates a video result.
+func NewInlineQueryResultVideo(id, videoURL, mimeType, thumbnailURL, title string) *InlineQueryResultVideo {
+	return &InlineQueryResultVideo{
+		ID:           id,
+		VideoURL:     videoURL,
+		MimeType:     mimeType,
+		ThumbnailURL: thumbnailURL,
+		Title:        title,
+	}
+}
+
+// NewInlineQueryResultAudio creates an audio result.
+func NewInlineQueryResultAudio(id, audioURL, title string) *InlineQueryResultAudio {
+	return &InlineQueryResultAudio{
+		ID:       id,
+		AudioURL: audioURL,
+		Title:    title,
+	}
+}
+
+// NewInlineQueryResultVoice creates a voice result.
+func NewInlineQueryResultVoice(id, voiceURL, title string) *InlineQueryResultVoice {
+	return &InlineQueryResultVoice{
+		ID:       id,
+		VoiceURL: voiceURL,
+		Title:    title,
+	}
+}
+
+// NewInlineQueryResultDocument creates a document result.
+func NewInlineQueryResultDocument(id, documentURL, mimeType, title string) *InlineQueryResultDocument {
+	return &InlineQueryResultDocument{
+		ID:          id,
+		DocumentURL: documentURL,
+		MimeType:    mimeType,
+		Title:       title,
+	}
+}
+
+// NewInlineQueryResultLocation creates a location result.
+func NewInlineQueryResultLocation(id string, latitude, longitude float64, title string) *InlineQueryResultLocation {
+	return &InlineQueryResultLocation{
+		ID:        id,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+	}
+}
+
+// NewInlineQueryResultVenue creates a venue result.
+func NewInlineQueryResultVenue(id string, latitude, longitude float64, title, address string) *InlineQueryResultVenue {
+	return &InlineQueryResultVenue{
+		ID:        id,
+		Latitude:  latitude,
+		Longitude: longitude,
+		Title:     title,
+		Address:   address,
+	}
+}
+
+// NewInlineQueryResultContact creates a contact result.
+func NewInlineQueryResultContact(id, phoneNumber, firstName string) *InlineQueryResultContact {
+	return &InlineQueryResultContact{
+		ID:          id,
+		PhoneNumber: phoneNumber,
+		FirstName:   firstName,
+	}
+}
+
+// NewInlineQueryResultGame creates a game result.
+func NewInlineQueryResultGame(id, gameShortName string) *InlineQueryResultGame {
+	return &InlineQueryResultGame{
+		ID:            id,
+		GameShortName: gameShortName,
+	}
+}
+
+// NewInlineQueryResultCachedPhoto creates a result for a photo stored on the Telegram servers.
+func NewInlineQueryResultCachedPhoto(id, photoFileID string) *InlineQueryResultCachedPhoto {
+	return &InlineQueryResultCachedPhoto{
+		ID:          id,
+		PhotoFileID: photoFileID,
+	}
+}
+
+// NewInlineQueryResultCachedGif creates a result for a GIF stored on the Telegram servers.
+func NewInlineQueryResultCachedGif(id, gifFileID string) *InlineQueryResultCachedGif {
+	return &InlineQueryResultCachedGif{
+		ID:        id,
+		GifFileID: gifFileID,
+	}
+}
+
+// NewInlineQueryResultCachedMpeg4Gif creates a result for an MPEG-4 animation stored on the Telegram servers.
+func NewInlineQueryResultCachedMpeg4Gif(id, mpeg4FileID string) *InlineQueryResultCachedMpeg4Gif {
+	return &InlineQueryResultCachedMpeg4Gif{
+		ID:          id,
+		Mpeg4FileID: mpeg4FileID,
+	}
+}
+
+// NewInlineQueryResultCachedSticker creates a result for a sticker stored on the Telegram servers.
+func NewInlineQueryResultCachedSticker(id, stickerFileID string) *InlineQueryResultCachedSticker {
+	return &InlineQueryResultCachedSticker{
+		ID:            id,
+		StickerFileID: stickerFileID,
+	}
+}
+
+// NewInlineQueryResultCachedDocument creates a result for a file stored on the Telegram servers.
+func NewInlineQueryResultCachedDocument(id, documentFileID, title string) *InlineQueryResultCachedDocument {
+	return &InlineQueryResultCachedDocument{
+		ID:             id,
+		DocumentFileID: documentFileID,
+		Title:          title,
+	}
+}
+
+// NewInlineQueryResultCachedVideo creates a result for a video stored on the Telegram servers.
+func NewInlineQueryResultCachedVideo(id, videoFileID, title string) *InlineQueryResultCachedVideo {
+	return &InlineQueryResultCachedVideo{
+		ID:          id,
+		VideoFileID: videoFileID,
+		Title:       title,
+	}
+}
+
+// NewInlineQueryResultCachedVoice creates a result for a voice message stored on the Telegram servers.
+func NewInlineQueryResultCachedVoice(id, voiceFileID, title string) *InlineQueryResultCachedVoice {
+	return &InlineQueryResultCachedVoice{
+		ID:          id,
+		VoiceFileID: voiceFileID,
+		Title:       title,
+	}
+}
+
+// NewInlineQueryResultCachedAudio creates a result for an audio file stored on the Telegram servers.
+func NewInlineQueryResultCachedAudio(id, audioFileID string) *InlineQueryResultCachedAudio {
+	return &InlineQueryResultCachedAudio{
+		ID:          id,
+		AudioFileID: audioFileID,
+	}
+}
+
+func (*InputTextMessageContent) inputMessageContent()     {}
+func (*InputLocationMessageContent) inputMessageContent() {}
+func (*InputVenueMessageContent) inputMessageContent()    {}
+func (*InputContactMessageContent) inputMessageContent()  {}
+func (*InputInvoiceMessageContent) inputMessageContent()  {}
@@ -0,0 +1,190 @@
+package gotelegrambot
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MaxInlineQueryResults is the maximum number of results allowed in one answer to an inline query.
+const MaxInlineQueryResults = 50
+
+// InlineQuerySource returns up to limit results for the query starting at offset,
+// together with the offset of the next page. A next offset of zero or less means
+// there are no more results.
+type InlineQuerySource func(ctx context.Context, query *InlineQuery, offset, limit int) (results []InlineQueryResult, nextOffset int, err error)
+
+// InlineQueryPager answers inline queries page by page from an InlineQuerySource.
+// It encodes the paging offsets, keeps every answer within the result limit and
+// drops queries superseded by a newer one from the same user while they type.
+type InlineQueryPager struct {
+	bot    *Bot
+	source InlineQuerySource
+
+	pageSize   int
+	cacheTime  *int
+	isPersonal bool
+	button     *InlineQueryResultsButton
+	debounce   time.Duration
+
+	// mu protects latest
+	mu     sync.Mutex
+	latest map[int64]uint64
+	seq    uint64
+}
+
+// InlineQueryPagerOption is a function that configures an InlineQueryPager.
+type InlineQueryPagerOption func(*InlineQueryPager)
+
+// WithPagerPageSize sets the number of results per page. It is capped at MaxInlineQueryResults.
+func WithPagerPageSize(size int) InlineQueryPagerOption {
+	return func(p *InlineQueryPager) {
+		p.pageSize = size
+	}
+}
+
+// WithPagerCacheTime sets the maximum time in seconds the results may be cached on the server.
+func WithPagerCacheTime(cacheTime int) InlineQueryPagerOption {
+	return func(p *InlineQueryPager) {
+		p.cacheTime = &cacheTime
+	}
+}
+
+// WithPagerPersonal makes the results cached only for the user that sent the query.
+func WithPagerPersonal(isPersonal bool) InlineQueryPagerOption {
+	return func(p *InlineQueryPager) {
+		p.isPersonal = isPersonal
+	}
+}
+
+// WithPagerButton sets the button shown above the results.
+func WithPagerButton(button *InlineQueryResultsButton) InlineQueryPagerOption {
+	return func(p *InlineQueryPager) {
+		p.button = button
+	}
+}
+
+// WithPagerDebounce sets how long to wait for a newer query from the same user
+// before answering. Superseded queries are answered with no results so that the
+// client stops waiting for them. A newer query can only supersede one that is
+// still waiting, so the debounce takes effect only when Handle runs concurrently.
+// Long polling handles every update in its own goroutine, and Telegram sends up
+// to max_connections webhook requests at once; with MaxConnections set to 1,
+// every query is answered after the delay. Queries without a sender are never
+// debounced.
+func WithPagerDebounce(debounce time.Duration) InlineQueryPagerOption {
+	return func(p *InlineQueryPager) {
+		p.debounce = debounce
+	}
+}
+
+// NewInlineQueryPager creates a pager that answers inline queries using source.
+func NewInlineQueryPager(bot *Bot, source InlineQuerySource, options ...InlineQueryPagerOption) *InlineQueryPager {
+	pager := &InlineQueryPager{
+		bot:      bot,
+		source:   source,
+		pageSize: MaxInlineQueryResults,
+		latest:   make(map[int64]uint64),
+	}
+
+	for _, option := range options {
+		option(pager)
+	}
+
+	if pager.pageSize <= 0 || pager.pageSize > MaxInlineQueryResults {
+		pager.pageSize = MaxInlineQueryResults
+	}
+
+	return pager
+}
+
+// Handle answers the inline query with the page of results its offset points to.
+func (p *InlineQueryPager) Handle(ctx context.Context, query *InlineQuery) error {
+	// Only the first page is debounced; later pages are requested by scrolling
+	if p.debounce > 0 && query.Offset == "" && query.From != nil {
+		latest, err := p.wait(ctx, query)
+		if err != nil {
+			return err
+		}
+		if !latest {
+			return p.bot.AnswerInlineQuery(ctx, query.ID, nil, WithInlineCacheTime(0), WithIsPersonal(true))
+		}
+	}
+
+	offset := DecodeInlineOffset(query.Offset)
+
+	results, next, err := p.source(ctx, query, offset, p.pageSize)
+	if err != nil {
+		return errors.Wrap(err, "failed to load inline query results")
+	}
+
+	if len(results) > p.pageSize {
+		results = results[:p.pageSize]
+		next = offset + p.pageSize
+	}
+
+	var options []AnswerInlineQueryOption
+	if p.cacheTime != nil {
+		options = append(options, WithInlineCacheTime(*p.cacheTime))
+	}
+	if p.isPersonal {
+		options = append(options, WithIsPersonal(true))
+	}
+	if p.button != nil {
+		options = append(options, WithInlineQueryButton(p.button))
+	}
+	if next > 0 {
+		options = append(options, WithNextOffset(EncodeInlineOffset(next)))
+	}
+
+	return p.bot.AnswerInlineQuery(ctx, query.ID, results, options...)
+}
+
+// wait sleeps for the debounce interval and reports whether query is still the
+// latest one received from its sender.
+func (p *InlineQueryPager) wait(ctx context.Context, query *InlineQuery) (bool, error) {
+	userID := query.From.ID
+
+	p.mu.Lock()
+	p.seq++
+	seq := p.seq
+	p.latest[userID] = seq
+	p.mu.Unlock()
+
+	timer := time.NewTimer(p.debounce)
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-timer.C:
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.latest[userID] != seq {
+		return false, err
+	}
+	delete(p.latest, userID)
+	return err == nil, err
+}
+
+// EncodeInlineOffset encodes a numeric offset for use as next_offset.
+func EncodeInlineOffset(offset int) string {
+	return strconv.FormatInt(int64(offset), 36)
+}
+
+// DecodeInlineOffset decodes an offset produced by EncodeInlineOffset.
+// An empty or malformed offset decodes to zero, the first page.
+func DecodeInlineOffset(offset string) int {
+	n, err := strconv.ParseInt(offset, 36, 64)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return int(n)
+}
@@ -0,0 +1,161 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInlineOffset(t *testing.T) {
+	for _, offset := range []int{0, 1, 35, 36, 50, 123456} {
+		assert.Equal(t, offset, DecodeInlineOffset(EncodeInlineOffset(offset)))
+	}
+	assert.Equal(t, "z", EncodeInlineOffset(35))
+
+	for _, offset := range []string{"", "!", "-1"} {
+		assert.Equal(t, 0, DecodeInlineOffset(offset), offset)
+	}
+}
+
+// inlineAnswers starts a server recording the parameters of answerInlineQuery
+// requests by inline query ID.
+func inlineAnswers(t *testing.T) (*Bot, func() map[string]map[string]interface{}) {
+	var mu sync.Mutex
+	answers := make(map[string]map[string]interface{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		answers[body["inline_query_id"].(string)] = body
+		mu.Unlock()
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	bot, _ := New("test_token")
+	bot.APIEndpoint = server.URL + "/bottest_token"
+	return bot, func() map[string]map[string]interface{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return answers
+	}
+}
+
+func TestInlineQueryPager(t *testing.T) {
+	bot, answers := inlineAnswers(t)
+
+	var offsets []int
+	source := func(ctx context.Context, query *InlineQuery, offset, limit int) ([]InlineQueryResult, int, error) {
+		offsets = append(offsets, offset)
+		// The source ignores the limit and returns more results than asked for
+		var results []InlineQueryResult
+		for i := offset; i < offset+limit+5 && i < 70; i++ {
+			results = append(results, NewInlineQueryResultArticleText(strconv.Itoa(i), "Result", "text"))
+		}
+		return results, 0, nil
+	}
+
+	pager := NewInlineQueryPager(bot, source, WithPagerPageSize(30), WithPagerCacheTime(5))
+	ctx := context.Background()
+
+	require.NoError(t, pager.Handle(ctx, &InlineQuery{ID: "1"}))
+	first := answers()["1"]
+	assert.Len(t, first["results"], 30)
+	assert.Equal(t, float64(5), first["cache_time"])
+	assert.Equal(t, EncodeInlineOffset(30), first["next_offset"])
+
+	require.NoError(t, pager.Handle(ctx, &InlineQuery{ID: "2", Offset: EncodeInlineOffset(30)}))
+	second := answers()["2"]
+	assert.Len(t, second["results"], 30)
+	assert.Equal(t, EncodeInlineOffset(60), second["next_offset"])
+
+	require.NoError(t, pager.Handle(ctx, &InlineQuery{ID: "3", Offset: EncodeInlineOffset(60)}))
+	last := answers()["3"]
+	assert.Len(t, last["results"], 10)
+	assert.NotContains(t, last, "next_offset")
+
+	assert.Equal(t, []int{0, 30, 60}, offsets)
+
+	pager = NewInlineQueryPager(bot, source, WithPagerPageSize(100))
+	assert.Equal(t, MaxInlineQueryResults, pager.pageSize)
+}
+
+func TestInlineQueryPagerDebounce(t *testing.T) {
+	bot, answers := inlineAnswers(t)
+
+	var mu sync.Mutex
+	var loaded []string
+	source := func(ctx context.Context, query *InlineQuery, offset, limit int) ([]InlineQueryResult, int, error) {
+		mu.Lock()
+		loaded = append(loaded, query.Query)
+		mu.Unlock()
+		return []InlineQueryResult{NewInlineQueryResultArticleText("1", query.Query, query.Query)}, 0, nil
+	}
+
+	pager := NewInlineQueryPager(bot, source, WithPagerDebounce(50*time.Millisecond))
+	ctx := context.Background()
+	from := &User{ID: 7}
+
+	done := make(chan error)
+	go func() {
+		done <- pager.Handle(ctx, &InlineQuery{ID: "1", From: from, Query: "ca"})
+	}()
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, pager.Handle(ctx, &InlineQuery{ID: "2", From: from, Query: "cat"}))
+	require.NoError(t, <-done)
+
+	assert.Equal(t, []string{"cat"}, loaded)
+	assert.Equal(t, []interface{}{}, answers()["1"]["results"])
+	assert.Equal(t, true, answers()["1"]["is_personal"])
+	assert.Len(t, answers()["2"]["results"], 1)
+}
+
+func TestInlineQueryPagerDebounceConcurrent(t *testing.T) {
+	bot, answers := inlineAnswers(t)
+
+	var mu sync.Mutex
+	loaded := make(map[int64]int)
+	source := func(ctx context.Context, query *InlineQuery, offset, limit int) ([]InlineQueryResult, int, error) {
+		if query.From != nil {
+			mu.Lock()
+			loaded[query.From.ID]++
+			mu.Unlock()
+		}
+		return []InlineQueryResult{NewInlineQueryResultArticleText("1", query.Query, query.Query)}, 0, nil
+	}
+
+	pager := NewInlineQueryPager(bot, source, WithPagerDebounce(200*time.Millisecond))
+	ctx := context.Background()
+
+	// Queries of several users arrive at once, as with concurrent webhook requests
+	var wg sync.WaitGroup
+	for user := int64(1); user <= 5; user++ {
+		for i := 0; i < 3; i++ {
+			query := &InlineQuery{ID: strconv.FormatInt(user*10+int64(i), 10), From: &User{ID: user}, Query: "q"}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, pager.Handle(ctx, query))
+			}()
+		}
+	}
+	wg.Wait()
+
+	for user := int64(1); user <= 5; user++ {
+		assert.Equal(t, 1, loaded[user], "user %d", user)
+	}
+	assert.Len(t, answers(), 15)
+	assert.Empty(t, pager.latest)
+
+	// Without a sender there is nothing to debounce by
+	require.NoError(t, pager.Handle(ctx, &InlineQuery{ID: "anonymous"}))
+	assert.Len(t, answers()["anonymous"]["results"], 1)
+}
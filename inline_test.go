@@ -0,0 +1,108 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInlineQueryResultTypes(t *testing.T) {
+	for _, tc := range []struct {
+		result InlineQueryResult
+		typ    string
+	}{
+		{NewInlineQueryResultArticleText("1", "Title", "text"), "article"},
+		{NewInlineQueryResultPhoto("1", "https://example.com/a.jpg", "https://example.com/t.jpg"), "photo"},
+		{NewInlineQueryResultGif("1", "https://example.com/a.gif", "https://example.com/t.jpg"), "gif"},
+		{NewInlineQueryResultMpeg4Gif("1", "https://example.com/a.mp4", "https://example.com/t.jpg"), "mpeg4_gif"},
+		{NewInlineQueryResultVideo("1", "https://example.com/a.mp4", "video/mp4", "https://example.com/t.jpg", "Video"), "video"},
+		{NewInlineQueryResultAudio("1", "https://example.com/a.mp3", "Audio"), "audio"},
+		{NewInlineQueryResultVoice("1", "https://example.com/a.ogg", "Voice"), "voice"},
+		{NewInlineQueryResultDocument("1", "https://example.com/a.pdf", "application/pdf", "Document"), "document"},
+		{NewInlineQueryResultLocation("1", 1.5, 2.5, "Location"), "location"},
+		{NewInlineQueryResultVenue("1", 1.5, 2.5, "Venue", "Address"), "venue"},
+		{NewInlineQueryResultContact("1", "+100", "Alice"), "contact"},
+		{NewInlineQueryResultGame("1", "game"), "game"},
+		{NewInlineQueryResultCachedPhoto("1", "file"), "photo"},
+		{NewInlineQueryResultCachedGif("1", "file"), "gif"},
+		{NewInlineQueryResultCachedMpeg4Gif("1", "file"), "mpeg4_gif"},
+		{NewInlineQueryResultCachedSticker("1", "file"), "sticker"},
+		{NewInlineQueryResultCachedDocument("1", "file", "Document"), "document"},
+		{NewInlineQueryResultCachedVideo("1", "file", "Video"), "video"},
+		{NewInlineQueryResultCachedVoice("1", "file", "Voice"), "voice"},
+		{NewInlineQueryResultCachedAudio("1", "file"), "audio"},
+	} {
+		data, err := json.Marshal(tc.result)
+		require.NoError(t, err)
+
+		var fields map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &fields))
+		assert.Equal(t, tc.typ, fields["type"], "%T", tc.result)
+		assert.Equal(t, "1", fields["id"], "%T", tc.result)
+
+		// Results built as struct literals get their type too
+		literal := reflect.New(reflect.TypeOf(tc.result).Elem()).Interface()
+		data, err = json.Marshal(literal)
+		require.NoError(t, err)
+		fields = nil
+		require.NoError(t, json.Unmarshal(data, &fields))
+		assert.Equal(t, tc.typ, fields["type"], "%T literal", tc.result)
+	}
+}
+
+func TestInputTextMessageContent(t *testing.T) {
+	data, err := json.Marshal(&InputTextMessageContent{
+		MessageText:        "https://example.com",
+		LinkPreviewOptions: &LinkPreviewOptions{IsDisabled: true},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"message_text":"https://example.com","link_preview_options":{"is_disabled":true}}`, string(data))
+}
+
+func TestAnswerInlineQuery(t *testing.T) {
+	var method string
+	var params map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = path.Base(r.URL.Path)
+		params = nil
+		json.NewDecoder(r.Body).Decode(&params)
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	defer server.Close()
+
+	bot, _ := New("test_token")
+	bot.APIEndpoint = server.URL + "/bottest_token"
+
+	err := bot.AnswerInlineQuery(context.Background(), "q1",
+		[]InlineQueryResult{NewInlineQueryResultArticleText("a", "Title", "Hello")},
+		WithInlineCacheTime(0),
+		WithIsPersonal(true),
+		WithNextOffset("10"),
+		WithSwitchPrivateChat("Sign in", "login"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "answerInlineQuery", method)
+	assert.Equal(t, "q1", params["inline_query_id"])
+	assert.Equal(t, float64(0), params["cache_time"])
+	assert.Equal(t, true, params["is_personal"])
+	assert.Equal(t, "10", params["next_offset"])
+	assert.Equal(t, map[string]interface{}{"text": "Sign in", "start_parameter": "login"}, params["button"])
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"type":                  "article",
+		"id":                    "a",
+		"title":                 "Title",
+		"input_message_content": map[string]interface{}{"message_text": "Hello"},
+	}}, params["results"])
+
+	require.NoError(t, bot.AnswerInlineQuery(context.Background(), "q2", nil))
+	assert.Equal(t, []interface{}{}, params["results"])
+	assert.NotContains(t, params, "cache_time")
+	assert.NotContains(t, params, "button")
+}
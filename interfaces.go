@@ -0,0 +1,138 @@
+package gotelegrambot
+
+import (
+	"context"
+)
+
+//go:generate go run ./cmd/mockgen -in interfaces.go -out botmock/bot.go
+
+// Sender sends messages to chats.
+type Sender interface {
+	SendMessage(ctx context.Context, chatID ChatID, text string, options ...SendMessageOption) (*Message, error)
+	SendLongMessage(ctx context.Context, chatID ChatID, text string, options ...SendMessageOption) ([]*Message, error)
+	SendPhoto(ctx context.Context, chatID ChatID, photo interface{}, options ...SendPhotoOption) (*Message, error)
+	SendDocument(ctx context.Context, params SendDocumentParams) (*Message, error)
+	SendVideo(ctx context.Context, params SendVideoParams) (*Message, error)
+	SendAudio(ctx context.Context, params SendAudioParams) (*Message, error)
+	SendAnimation(ctx context.Context, params SendAnimationParams) (*Message, error)
+	SendVoice(ctx context.Context, params SendVoiceParams) (*Message, error)
+	SendLocation(ctx context.Context, params SendLocationParams) (*Message, error)
+	SendVenue(ctx context.Context, params SendVenueParams) (*Message, error)
+	SendContact(ctx context.Context, params SendContactParams) (*Message, error)
+	SendDice(ctx context.Context, params SendDiceParams) (*Message, error)
+	SendPoll(ctx context.Context, chatID ChatID, question string, options []string, pollOptions ...SendPollOption) (*Message, error)
+	SendInvoice(ctx context.Context, chatID ChatID, title, description, payload, providerToken, currency string, prices []LabeledPrice, options ...SendInvoiceOption) (*Message, error)
+	SendChatAction(ctx context.Context, chatID ChatID, action string, options ...ChatActionOption) error
+	ForwardMessage(ctx context.Context, chatID ChatID, fromChatID ChatID, messageID int, options ...ForwardMessageOption) (*Message, error)
+	CopyMessage(ctx context.Context, chatID ChatID, fromChatID ChatID, messageID int, options ...CopyMessageOption) (*MessageID, error)
+}
+
+// Editor edits and deletes sent messages.
+type Editor interface {
+	EditMessageText(ctx context.Context, options ...EditMessageTextOption) (*Message, error)
+	EditMessageCaption(ctx context.Context, params EditMessageCaptionParams) (*Message, error)
+	EditMessageReplyMarkup(ctx context.Context, params EditMessageReplyMarkupParams) (*Message, error)
+	DeleteMessage(ctx context.Context, chatID ChatID, messageID int) error
+	StopPoll(ctx context.Context, chatID ChatID, messageID int, replyMarkup *InlineKeyboardMarkup) (*Poll, error)
+}
+
+// QueryAnswerer answers callback, inline, shipping and pre-checkout queries.
+type QueryAnswerer interface {
+	AnswerCallbackQuery(ctx context.Context, callbackQueryID string, options ...AnswerCallbackQueryOption) error
+	AnswerInlineQuery(ctx context.Context, inlineQueryID string, results []InlineQueryResult, options ...AnswerInlineQueryOption) error
+	AnswerShippingQuery(ctx context.Context, shippingQueryID string, ok bool, options ...AnswerShippingQueryOption) error
+	AnswerPreCheckoutQuery(ctx context.Context, preCheckoutQueryID string, ok bool, errorMessage string) error
+}
+
+// ChatReader reads chats, their members and users.
+type ChatReader interface {
+	GetChat(ctx context.Context, chatID ChatID) (*ChatFullInfo, error)
+	GetChatAdministrators(ctx context.Context, chatID ChatID) ([]ChatMember, error)
+	GetChatMember(ctx context.Context, chatID ChatID, userID int64) (*ChatMember, error)
+	GetChatMemberCount(ctx context.Context, chatID ChatID) (int, error)
+	GetUserProfilePhotos(ctx context.Context, params GetUserProfilePhotosParams) (*UserProfilePhotos, error)
+}
+
+// ChatAdmin moderates chats and their members.
+type ChatAdmin interface {
+	BanChatMember(ctx context.Context, chatID ChatID, userID int64, options ...BanChatMemberOption) error
+	UnbanChatMember(ctx context.Context, chatID ChatID, userID int64, onlyIfBanned bool) error
+	RestrictChatMember(ctx context.Context, chatID ChatID, userID int64, permissions ChatPermissions, options ...RestrictChatMemberOption) error
+	PromoteChatMember(ctx context.Context, chatID ChatID, userID int64, rights ChatAdministratorRights) error
+	SetChatAdministratorCustomTitle(ctx context.Context, chatID ChatID, userID int64, customTitle string) error
+	SetChatPermissions(ctx context.Context, chatID ChatID, permissions ChatPermissions, useIndependentChatPermissions bool) error
+	SetChatTitle(ctx context.Context, chatID ChatID, title string) error
+	SetChatDescription(ctx context.Context, chatID ChatID, description string) error
+	SetChatPhoto(ctx context.Context, chatID ChatID, photoPath string) error
+	DeleteChatPhoto(ctx context.Context, chatID ChatID) error
+	PinChatMessage(ctx context.Context, chatID ChatID, messageID int, disableNotification bool) error
+	UnpinChatMessage(ctx context.Context, chatID ChatID, messageID int) error
+	UnpinAllChatMessages(ctx context.Context, chatID ChatID) error
+	LeaveChat(ctx context.Context, chatID ChatID) error
+}
+
+// InviteManager manages invite links and join requests.
+type InviteManager interface {
+	CreateChatInviteLink(ctx context.Context, chatID ChatID, options ...ChatInviteLinkOption) (*ChatInviteLink, error)
+	EditChatInviteLink(ctx context.Context, chatID ChatID, inviteLink string, options ...ChatInviteLinkOption) (*ChatInviteLink, error)
+	RevokeChatInviteLink(ctx context.Context, chatID ChatID, inviteLink string) (*ChatInviteLink, error)
+	ExportChatInviteLink(ctx context.Context, chatID ChatID) (string, error)
+	ApproveChatJoinRequest(ctx context.Context, chatID ChatID, userID int64) error
+	DeclineChatJoinRequest(ctx context.Context, chatID ChatID, userID int64) error
+}
+
+// ForumManager manages the topics of forum supergroups.
+type ForumManager interface {
+	CreateForumTopic(ctx context.Context, chatID ChatID, name string, options ...CreateForumTopicOption) (*ForumTopic, error)
+	EditForumTopic(ctx context.Context, chatID ChatID, messageThreadID int, options ...EditForumTopicOption) error
+	CloseForumTopic(ctx context.Context, chatID ChatID, messageThreadID int) error
+	ReopenForumTopic(ctx context.Context, chatID ChatID, messageThreadID int) error
+	DeleteForumTopic(ctx context.Context, chatID ChatID, messageThreadID int) error
+	UnpinAllForumTopicMessages(ctx context.Context, chatID ChatID, messageThreadID int) error
+	EditGeneralForumTopic(ctx context.Context, chatID ChatID, name string) error
+	CloseGeneralForumTopic(ctx context.Context, chatID ChatID) error
+	ReopenGeneralForumTopic(ctx context.Context, chatID ChatID) error
+	HideGeneralForumTopic(ctx context.Context, chatID ChatID) error
+	UnhideGeneralForumTopic(ctx context.Context, chatID ChatID) error
+	UnpinAllGeneralForumTopicMessages(ctx context.Context, chatID ChatID) error
+	GetForumTopicIconStickers(ctx context.Context) ([]Sticker, error)
+}
+
+// FileService gets and downloads files.
+type FileService interface {
+	GetFile(ctx context.Context, fileID string) (*File, error)
+	DownloadFile(ctx context.Context, file *File, destPath string) error
+	GetChatPhotoFile(ctx context.Context, chatID ChatID, big bool) (*File, error)
+	DownloadChatPhoto(ctx context.Context, chatID ChatID, big bool, destPath string) error
+}
+
+// BotProfile reads and changes the bot's own profile.
+type BotProfile interface {
+	GetMe(ctx context.Context) (*User, error)
+	GetMyName(ctx context.Context, params GetMyNameParams) (*BotName, error)
+	SetMyName(ctx context.Context, params SetMyNameParams) error
+}
+
+// WebhookManager configures the webhook.
+type WebhookManager interface {
+	SetWebhook(ctx context.Context, config WebhookConfig) error
+	DeleteWebhook(ctx context.Context, dropPendingUpdates bool) error
+	GetWebhookInfo(ctx context.Context) (*WebhookInfo, error)
+}
+
+// API groups all the interfaces above. Depend on the smallest interface that
+// covers what the code uses, and use the botmock package to fake it in tests.
+type API interface {
+	Sender
+	Editor
+	QueryAnswerer
+	ChatReader
+	ChatAdmin
+	InviteManager
+	ForumManager
+	FileService
+	BotProfile
+	WebhookManager
+}
+
+var _ API = (*Bot)(nil)
@@ -0,0 +1,365 @@
+package gotelegrambot
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CreateChatInviteLink creates an additional invite link for a chat.
+func (b *Bot) CreateChatInviteLink(ctx context.Context, chatID ChatID, options ...ChatInviteLinkOption) (*ChatInviteLink, error) {
+	opts := applyChatInviteLinkOptions(options)
+	params := CreateChatInviteLinkParams{
+		ChatID:             chatID,
+		Name:               opts.Name,
+		ExpireDate:         opts.ExpireDate,
+		MemberLimit:        opts.MemberLimit,
+		CreatesJoinRequest: opts.CreatesJoinRequest != nil && *opts.CreatesJoinRequest,
+	}
+
+	return params.do(ctx, b)
+}
+
+// EditChatInviteLink edits a non-primary invite link created by the bot.
+func (b *Bot) EditChatInviteLink(ctx context.Context, chatID ChatID, inviteLink string, options ...ChatInviteLinkOption) (*ChatInviteLink, error) {
+	opts := applyChatInviteLinkOptions(options)
+	params := EditChatInviteLinkParams{
+		ChatID:             chatID,
+		InviteLink:         inviteLink,
+		Name:               opts.Name,
+		ExpireDate:         opts.ExpireDate,
+		MemberLimit:        opts.MemberLimit,
+		CreatesJoinRequest: opts.CreatesJoinRequest,
+	}
+
+	return params.do(ctx, b)
+}
+
+// RevokeChatInviteLink revokes an invite link created by the bot.
+func (b *Bot) RevokeChatInviteLink(ctx context.Context, chatID ChatID, inviteLink string) (*ChatInviteLink, error) {
+	return RevokeChatInviteLinkParams{ChatID: chatID, InviteLink: inviteLink}.do(ctx, b)
+}
+
+// ExportChatInviteLink generates a new primary invite link for a chat, revoking the previous one.
+func (b *Bot) ExportChatInviteLink(ctx context.Context, chatID ChatID) (string, error) {
+	return ExportChatInviteLinkParams{ChatID: chatID}.do(ctx, b)
+}
+
+// ChatInviteLinkOption is a function that configures CreateChatInviteLink and EditChatInviteLink options.
+type ChatInviteLinkOption func(*chatInviteLinkOptions)
+
+// chatInviteLinkOptions represents options for CreateChatInviteLink and EditChatInviteLink.
+type chatInviteLinkOptions struct {
+	Name               string
+	ExpireDate         int
+	MemberLimit        int
+	CreatesJoinRequest *bool
+}
+
+// WithInviteLinkName sets the name of the invite link.
+func WithInviteLinkName(name string) ChatInviteLinkOption {
+	return func(o *chatInviteLinkOptions) {
+		o.Name = name
+	}
+}
+
+// WithInviteLinkExpireDate sets the date when the link will expire, as a Unix time.
+func WithInviteLinkExpireDate(expireDate int) ChatInviteLinkOption {
+	return func(o *chatInviteLinkOptions) {
+		o.ExpireDate = expireDate
+	}
+}
+
+// WithInviteLinkMemberLimit sets the maximum number of users that can be members of the chat after joining via the link.
+func WithInviteLinkMemberLimit(memberLimit int) ChatInviteLinkOption {
+	return func(o *chatInviteLinkOptions) {
+		o.MemberLimit = memberLimit
+	}
+}
+
+// WithCreatesJoinRequest makes users joining via the link go through a join request
+// that chat administrators have to approve. It can't be combined with a member limit.
+// Passing false when editing a link stops requiring approval.
+func WithCreatesJoinRequest(createsJoinRequest bool) ChatInviteLinkOption {
+	return func(o *chatInviteLinkOptions) {
+		o.CreatesJoinRequest = &createsJoinRequest
+	}
+}
+
+func defaultChatInviteLinkOptions() chatInviteLinkOptions {
+	return chatInviteLinkOptions{}
+}
+
+func applyChatInviteLinkOptions(options []ChatInviteLinkOption) chatInviteLinkOptions {
+	opts := defaultChatInviteLinkOptions()
+	for _, opt := range options {
+		opt(&opts)
+	}
+	return opts
+}
+
+// ApproveChatJoinRequest approves a chat join request.
+func (b *Bot) ApproveChatJoinRequest(ctx context.Context, chatID ChatID, userID int64) error {
+	return ApproveChatJoinRequestParams{ChatID: chatID, UserID: userID}.do(ctx, b)
+}
+
+// DeclineChatJoinRequest declines a chat join request.
+func (b *Bot) DeclineChatJoinRequest(ctx context.Context, chatID ChatID, userID int64) error {
+	return DeclineChatJoinRequestParams{ChatID: chatID, UserID: userID}.do(ctx, b)
+}
+
+// ChatJoinRequestHandler is a function that handles a chat join request.
+type ChatJoinRequestHandler func(ctx context.Context, request *ChatJoinRequest) error
+
+// OnChatJoinRequest registers a handler for chat join requests.
+func (b *Bot) OnChatJoinRequest(handler ChatJoinRequestHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.joinRequestHooks = append(b.joinRequestHooks, handler)
+}
+
+// runJoinRequestHooks calls the registered join request handlers.
+func (b *Bot) runJoinRequestHooks(ctx context.Context, update *Update) error {
+	if update.ChatJoinRequest == nil {
+		return nil
+	}
+
+	b.mu.RLock()
+	hooks := b.joinRequestHooks
+	b.mu.RUnlock()
+
+	for _, hook := range hooks {
+		if err := hook(ctx, update.ChatJoinRequest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// joinVerifierPrefix prefixes the callback data of join challenge buttons.
+const joinVerifierPrefix = "joinverify:"
+
+// JoinChallenge is a multiple choice question an applicant has to answer.
+type JoinChallenge struct {
+	Question string
+	Options  []string
+	Correct  int
+}
+
+// JoinVerifier approves chat join requests once the applicant answers a
+// challenge sent to them in a private chat, and declines them otherwise.
+type JoinVerifier struct {
+	bot       *Bot
+	challenge func(request *ChatJoinRequest) JoinChallenge
+	timeout   time.Duration
+	onResult  func(ctx context.Context, request *ChatJoinRequest, approved bool)
+
+	mu      sync.Mutex
+	pending map[string]*pendingJoin
+	seq     uint64
+}
+
+// pendingJoin is a join request waiting for the applicant's answer.
+type pendingJoin struct {
+	request   *ChatJoinRequest
+	correct   int
+	messageID int
+	timer     *time.Timer
+
+	// sent is closed once the challenge was sent and messageID is known.
+	sent chan struct{}
+}
+
+// JoinVerifierOption is a function that configures a JoinVerifier.
+type JoinVerifierOption func(*JoinVerifier)
+
+// WithJoinChallenge sets the function generating a challenge for each request.
+func WithJoinChallenge(challenge func(request *ChatJoinRequest) JoinChallenge) JoinVerifierOption {
+	return func(v *JoinVerifier) {
+		v.challenge = challenge
+	}
+}
+
+// WithJoinTimeout sets how long the applicant has to answer before the request is declined.
+func WithJoinTimeout(timeout time.Duration) JoinVerifierOption {
+	return func(v *JoinVerifier) {
+		v.timeout = timeout
+	}
+}
+
+// WithJoinResultHandler sets a function called after a request was approved or declined.
+func WithJoinResultHandler(onResult func(ctx context.Context, request *ChatJoinRequest, approved bool)) JoinVerifierOption {
+	return func(v *JoinVerifier) {
+		v.onResult = onResult
+	}
+}
+
+// VerifyJoinRequests registers a JoinVerifier handling every join request the bot receives.
+func (b *Bot) VerifyJoinRequests(options ...JoinVerifierOption) *JoinVerifier {
+	verifier := &JoinVerifier{
+		bot:       b,
+		challenge: arithmeticChallenge,
+		timeout:   5 * time.Minute,
+		pending:   make(map[string]*pendingJoin),
+	}
+
+	for _, option := range options {
+		option(verifier)
+	}
+
+	b.OnChatJoinRequest(verifier.handleJoinRequest)
+	b.OnCallbackQuery(joinVerifierPrefix, verifier.handleCallback)
+
+	return verifier
+}
+
+// arithmeticChallenge asks for the sum of two small numbers.
+func arithmeticChallenge(*ChatJoinRequest) JoinChallenge {
+	x, y := rand.Intn(10)+1, rand.Intn(10)+1
+	sum := x + y
+
+	challenge := JoinChallenge{
+		Question: fmt.Sprintf("To join, please answer: what is %d + %d?", x, y),
+		Correct:  rand.Intn(4),
+	}
+	for i := 0; i < 4; i++ {
+		challenge.Options = append(challenge.Options, strconv.Itoa(sum+i-challenge.Correct))
+	}
+
+	return challenge
+}
+
+func (v *JoinVerifier) handleJoinRequest(ctx context.Context, request *ChatJoinRequest) error {
+	challenge := v.challenge(request)
+
+	v.mu.Lock()
+	v.seq++
+	token := strconv.FormatUint(v.seq, 36)
+	v.mu.Unlock()
+
+	var row []InlineKeyboardButton
+	for i, option := range challenge.Options {
+		row = append(row, NewInlineKeyboardButtonCallback(option, joinVerifierPrefix+token+":"+strconv.Itoa(i)))
+	}
+
+	// The applicant may answer before SendMessage returns, so the challenge
+	// is pending from the start
+	pending := &pendingJoin{
+		request: request,
+		correct: challenge.Correct,
+		sent:    make(chan struct{}),
+	}
+
+	v.mu.Lock()
+	v.pending[token] = pending
+	pending.timer = time.AfterFunc(v.timeout, func() {
+		if v.take(token) == nil {
+			return
+		}
+		if err := v.finish(context.Background(), pending, false, "Time is up, your request to join was declined."); err != nil {
+			v.bot.debug("Error declining expired join request: %v", err)
+		}
+	})
+	v.mu.Unlock()
+
+	message, err := v.bot.SendMessage(ctx, NewChatID(request.UserChatID), challenge.Question,
+		WithReplyMarkup(NewInlineKeyboardMarkup(row)))
+	if err == nil {
+		pending.messageID = message.MessageID
+	}
+	close(pending.sent)
+
+	if err != nil {
+		if v.take(token) != nil {
+			pending.timer.Stop()
+		}
+		return errors.Wrap(err, "failed to send join challenge")
+	}
+
+	return nil
+}
+
+func (v *JoinVerifier) handleCallback(ctx context.Context, query *CallbackQuery) error {
+	parts := strings.Split(strings.TrimPrefix(query.Data, joinVerifierPrefix), ":")
+	if len(parts) != 2 {
+		return nil
+	}
+
+	v.mu.Lock()
+	pending := v.pending[parts[0]]
+	v.mu.Unlock()
+
+	if pending == nil {
+		return v.bot.AnswerCallbackQuery(ctx, query.ID, WithCallbackText("This challenge has expired."))
+	}
+	if query.From == nil || pending.request.From == nil || query.From.ID != pending.request.From.ID {
+		return v.bot.AnswerCallbackQuery(ctx, query.ID)
+	}
+	if v.take(parts[0]) == nil {
+		return v.bot.AnswerCallbackQuery(ctx, query.ID)
+	}
+	pending.timer.Stop()
+
+	answer, err := strconv.Atoi(parts[1])
+	approved := err == nil && answer == pending.correct
+
+	if err := v.bot.AnswerCallbackQuery(ctx, query.ID); err != nil {
+		v.bot.debug("Error answering join challenge callback: %v", err)
+	}
+
+	if approved {
+		return v.finish(ctx, pending, true, "Thanks, your request to join was approved.")
+	}
+	return v.finish(ctx, pending, false, "Wrong answer, your request to join was declined.")
+}
+
+// take removes and returns the pending request for token, if it is still pending.
+func (v *JoinVerifier) take(token string) *pendingJoin {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	pending := v.pending[token]
+	delete(v.pending, token)
+	return pending
+}
+
+// finish approves or declines the request and tells the applicant about it.
+func (v *JoinVerifier) finish(ctx context.Context, pending *pendingJoin, approved bool, text string) error {
+	request := pending.request
+
+	var err error
+	if approved {
+		err = v.bot.ApproveChatJoinRequest(ctx, request.Chat.ChatID(), request.From.ID)
+	} else {
+		err = v.bot.DeclineChatJoinRequest(ctx, request.Chat.ChatID(), request.From.ID)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve join request")
+	}
+
+	// Wait for the challenge to be sent to know which message to update
+	<-pending.sent
+	if pending.messageID != 0 {
+		if _, err := v.bot.EditMessageText(ctx,
+			WithChatID(NewChatID(request.UserChatID)),
+			WithMessageID(pending.messageID),
+			WithText(text),
+		); err != nil {
+			v.bot.debug("Error updating join challenge message: %v", err)
+		}
+	}
+
+	if v.onResult != nil {
+		v.onResult(ctx, request, approved)
+	}
+
+	return nil
+}
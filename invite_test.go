@@ -0,0 +1,224 @@
+package gotelegrambot_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/KazeDevID/gotelegrambot/telegramtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verifyJoinRequests sets up a JoinVerifier asking a fixed question whose
+// second option is correct, and returns the channel receiving its results.
+func verifyJoinRequests(h *telegramtest.Harness, options ...gotelegrambot.JoinVerifierOption) <-chan bool {
+	results := make(chan bool, 1)
+	options = append([]gotelegrambot.JoinVerifierOption{
+		gotelegrambot.WithJoinChallenge(func(*gotelegrambot.ChatJoinRequest) gotelegrambot.JoinChallenge {
+			return gotelegrambot.JoinChallenge{Question: "What is 2 + 2?", Options: []string{"3", "4", "5"}, Correct: 1}
+		}),
+		gotelegrambot.WithJoinResultHandler(func(ctx context.Context, request *gotelegrambot.ChatJoinRequest, approved bool) {
+			results <- approved
+		}),
+	}, options...)
+	h.Bot.VerifyJoinRequests(options...)
+	return results
+}
+
+func TestJoinVerifier(t *testing.T) {
+	alice := telegramtest.NewUser(42, "Alice")
+	mallory := telegramtest.NewUser(66, "Mallory")
+	group := telegramtest.NewGroupChat(-100123, "Group")
+
+	for _, tc := range []struct {
+		name     string
+		answer   int
+		approved bool
+		method   string
+		text     string
+	}{
+		{"correct answer", 1, true, "approveChatJoinRequest", "Thanks, your request to join was approved."},
+		{"wrong answer", 2, false, "declineChatJoinRequest", "Wrong answer, your request to join was declined."},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := telegramtest.NewHarness(t)
+			results := verifyJoinRequests(h)
+
+			require.NoError(t, h.Run(nil, telegramtest.NewChatJoinRequest(alice, group)))
+			h.ExpectCalls("sendMessage")
+			keyboard := h.ExpectCall("sendMessage").
+				Param("chat_id", 42).
+				TextMatches(`^What is 2 \+ 2\?$`).
+				InlineButtons(3).
+				InlineKeyboard()
+			challenge := h.Server.LastMessage(42)
+
+			// Only the applicant can answer
+			require.NoError(t, h.Run(nil, telegramtest.NewCallbackQuery(mallory, challenge, keyboard.InlineKeyboard[0][1].CallbackData)))
+			h.ExpectCalls("answerCallbackQuery")
+
+			require.NoError(t, h.Run(nil, telegramtest.NewCallbackQuery(alice, challenge, keyboard.InlineKeyboard[0][tc.answer].CallbackData)))
+			h.ExpectCalls("answerCallbackQuery", tc.method, "editMessageText")
+			h.ExpectCall(tc.method).Param("chat_id", -100123).Param("user_id", 42)
+			assert.Equal(t, tc.approved, <-results)
+			assert.Equal(t, tc.text, h.Server.LastMessage(42).Text)
+
+			// The challenge can't be answered twice
+			require.NoError(t, h.Run(nil, telegramtest.NewCallbackQuery(alice, challenge, keyboard.InlineKeyboard[0][1].CallbackData)))
+			h.ExpectCalls("answerCallbackQuery")
+			h.ExpectCall("answerCallbackQuery").Param("text", "This challenge has expired.")
+		})
+	}
+}
+
+func TestJoinVerifierTimeout(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	results := verifyJoinRequests(h, gotelegrambot.WithJoinTimeout(10*time.Millisecond))
+	alice := telegramtest.NewUser(42, "Alice")
+
+	require.NoError(t, h.Run(nil, telegramtest.NewChatJoinRequest(alice, telegramtest.NewGroupChat(-100123, "Group"))))
+
+	select {
+	case approved := <-results:
+		assert.False(t, approved)
+	case <-time.After(time.Second):
+		t.Fatal("join request was not declined")
+	}
+
+	declined := h.Server.CallsTo("declineChatJoinRequest")
+	require.Len(t, declined, 1)
+	assert.Equal(t, int64(42), declined[0].Int64("user_id"))
+	assert.Empty(t, h.Server.CallsTo("approveChatJoinRequest"))
+	assert.Equal(t, "Time is up, your request to join was declined.", h.Server.LastMessage(42).Text)
+}
+
+func TestJoinVerifierAnswerWhileSending(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	results := verifyJoinRequests(h)
+	alice := telegramtest.NewUser(42, "Alice")
+	chat := telegramtest.NewPrivateChat(alice)
+
+	// Alice answers before the bot learns that the challenge was sent
+	pressed := make(chan int, 1)
+	h.Server.Handle("sendMessage", func(call telegramtest.Call) (interface{}, error) {
+		var markup gotelegrambot.InlineKeyboardMarkup
+		require.NoError(t, json.Unmarshal([]byte(call.String("reply_markup")), &markup))
+		message := &gotelegrambot.Message{MessageID: 99, Chat: &chat, Text: call.String("text")}
+
+		update := telegramtest.NewCallbackQuery(alice, message, markup.InlineKeyboard[0][1].CallbackData).Update()
+		go func() {
+			data, _ := json.Marshal(update)
+			recorder := httptest.NewRecorder()
+			h.Bot.WebhookHandler(nil).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(data)))
+			pressed <- recorder.Code
+		}()
+
+		require.Eventually(t, func() bool {
+			return len(h.Server.CallsTo("answerCallbackQuery")) > 0
+		}, time.Second, time.Millisecond)
+		return message, nil
+	})
+
+	require.NoError(t, h.Run(nil, telegramtest.NewChatJoinRequest(alice, telegramtest.NewGroupChat(-100123, "Group"))))
+	select {
+	case approved := <-results:
+		assert.True(t, approved)
+	case <-time.After(time.Second):
+		t.Fatal("join request was not resolved")
+	}
+	assert.Equal(t, http.StatusOK, <-pressed)
+
+	answers := h.Server.CallsTo("answerCallbackQuery")
+	require.Len(t, answers, 1)
+	assert.Empty(t, answers[0].String("text"))
+	require.Len(t, h.Server.CallsTo("approveChatJoinRequest"), 1)
+	edits := h.Server.CallsTo("editMessageText")
+	require.Len(t, edits, 1)
+	assert.Equal(t, int64(99), edits[0].Int64("message_id"))
+}
+
+func TestJoinVerifierSendFailure(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	verifyJoinRequests(h)
+	alice := telegramtest.NewUser(42, "Alice")
+
+	h.Server.Fail("sendMessage", telegramtest.Failure{Code: 403, Description: "Forbidden: bot can't initiate conversation with a user"})
+	assert.Error(t, h.Run(nil, telegramtest.NewChatJoinRequest(alice, telegramtest.NewGroupChat(-100123, "Group"))))
+
+	// The challenge that failed to send is no longer pending
+	message := telegramtest.NewTextMessage(alice, telegramtest.NewPrivateChat(alice), "challenge").Message()
+	require.NoError(t, h.Run(nil, telegramtest.NewCallbackQuery(alice, message, "joinverify:1:0")))
+	h.ExpectCalls("answerCallbackQuery")
+	h.ExpectCall("answerCallbackQuery").Param("text", "This challenge has expired.")
+}
+
+func TestCallbackQueryRoutes(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	alice := telegramtest.NewUser(42, "Alice")
+
+	var routed []string
+	route := func(name string) gotelegrambot.CallbackQueryHandler {
+		return func(ctx context.Context, query *gotelegrambot.CallbackQuery) error {
+			routed = append(routed, name+" "+query.Data)
+			return nil
+		}
+	}
+	h.Bot.OnCallbackQuery("order:cancel:", route("cancel"))
+	h.Bot.OnCallbackQuery("order:", route("order"))
+
+	var handled []string
+	handler := func(ctx context.Context, update *gotelegrambot.Update) error {
+		handled = append(handled, update.CallbackQuery.Data)
+		return nil
+	}
+
+	message := telegramtest.NewTextMessage(alice, telegramtest.NewPrivateChat(alice), "orders").Message()
+	for _, data := range []string{"order:cancel:1", "order:2", "other", "order"} {
+		require.NoError(t, h.Run(handler, telegramtest.NewCallbackQuery(alice, message, data)))
+	}
+
+	// The first matching route runs, and the handler still sees every query
+	assert.Equal(t, []string{"cancel order:cancel:1", "order order:2"}, routed)
+	assert.Equal(t, []string{"order:cancel:1", "order:2", "other", "order"}, handled)
+}
+
+func TestChatInviteLinkOptions(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	ctx := context.Background()
+	group := gotelegrambot.NewChatID(-100123)
+
+	h.Server.Handle("createChatInviteLink", func(call telegramtest.Call) (interface{}, error) {
+		return gotelegrambot.ChatInviteLink{InviteLink: "https://t.me/+abc", CreatesJoinRequest: true}, nil
+	})
+	h.Server.Handle("editChatInviteLink", func(call telegramtest.Call) (interface{}, error) {
+		return gotelegrambot.ChatInviteLink{InviteLink: "https://t.me/+abc"}, nil
+	})
+
+	link, err := h.Bot.CreateChatInviteLink(ctx, group,
+		gotelegrambot.WithInviteLinkName("Applicants"),
+		gotelegrambot.WithCreatesJoinRequest(true))
+	require.NoError(t, err)
+	assert.True(t, link.CreatesJoinRequest)
+	h.ExpectCall("createChatInviteLink").
+		Param("chat_id", -100123).
+		Param("name", "Applicants").
+		Param("creates_join_request", true)
+
+	_, err = h.Bot.EditChatInviteLink(ctx, group, link.InviteLink, gotelegrambot.WithCreatesJoinRequest(false))
+	require.NoError(t, err)
+	h.ExpectCall("editChatInviteLink").
+		Param("invite_link", "https://t.me/+abc").
+		Param("creates_join_request", false)
+
+	_, err = h.Bot.EditChatInviteLink(ctx, group, link.InviteLink, gotelegrambot.WithInviteLinkMemberLimit(10))
+	require.NoError(t, err)
+	h.ExpectCall("editChatInviteLink").
+		Param("member_limit", 10).
+		NoParam("creates_join_request")
+}
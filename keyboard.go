@@ -1,75 +1,17 @@
 package gotelegrambot
 
-// ReplyKeyboardMarkup represents a custom keyboard with reply options.
-type ReplyKeyboardMarkup struct {
-	Keyboard              [][]KeyboardButton `json:"keyboard"`
-	IsPersistent          bool               `json:"is_persistent,omitempty"`
-	ResizeKeyboard        bool               `json:"resize_keyboard,omitempty"`
-	OneTimeKeyboard       bool               `json:"one_time_keyboard,omitempty"`
-	InputFieldPlaceholder string             `json:"input_field_placeholder,omitempty"`
-	Selective             bool               `json:"selective,omitempty"`
+// ReplyMarkup is the reply markup of a sent message: an inline keyboard, a
+// custom reply keyboard, the removal of the reply keyboard or a forced reply.
+// It is implemented by *InlineKeyboardMarkup, *ReplyKeyboardMarkup,
+// *ReplyKeyboardRemove and *ForceReply only.
+type ReplyMarkup interface {
+	replyMarkup()
 }
 
-// KeyboardButton represents one button of the reply keyboard.
-type KeyboardButton struct {
-	Text            string                      `json:"text"`
-	RequestContact  bool                        `json:"request_contact,omitempty"`
-	RequestLocation bool                        `json:"request_location,omitempty"`
-	RequestPoll     *KeyboardButtonPollType     `json:"request_poll,omitempty"`
-	WebApp          *WebAppInfo                 `json:"web_app,omitempty"`
-}
-
-// KeyboardButtonPollType represents type of a poll which is allowed to be created.
-type KeyboardButtonPollType struct {
-	Type string `json:"type,omitempty"`
-}
-
-// WebAppInfo contains information about a Web App.
-type WebAppInfo struct {
-	URL string `json:"url"`
-}
-
-// ReplyKeyboardRemove represents a message to remove a custom keyboard.
-type ReplyKeyboardRemove struct {
-	RemoveKeyboard bool `json:"remove_keyboard"`
-	Selective      bool `json:"selective,omitempty"`
-}
-
-// InlineKeyboardMarkup represents an inline keyboard attached to a message.
-type InlineKeyboardMarkup struct {
-	InlineKeyboard [][]InlineKeyboardButton `json:"inline_keyboard"`
-}
-
-// InlineKeyboardButton represents one button of an inline keyboard.
-type InlineKeyboardButton struct {
-	Text                         string        `json:"text"`
-	URL                          string        `json:"url,omitempty"`
-	CallbackData                 string        `json:"callback_data,omitempty"`
-	WebApp                       *WebAppInfo   `json:"web_app,omitempty"`
-	LoginURL                     *LoginURL     `json:"login_url,omitempty"`
-	SwitchInlineQuery            string        `json:"switch_inline_query,omitempty"`
-	SwitchInlineQueryCurrentChat string        `json:"switch_inline_query_current_chat,omitempty"`
-	CallbackGame                 *CallbackGame `json:"callback_game,omitempty"`
-	Pay                          bool          `json:"pay,omitempty"`
-}
-
-// LoginURL represents a parameter of the inline keyboard button used to automatically authorize a user.
-type LoginURL struct {
-	URL                string `json:"url"`
-	ForwardText        string `json:"forward_text,omitempty"`
-	BotUsername        string `json:"bot_username,omitempty"`
-	RequestWriteAccess bool   `json:"request_write_access,omitempty"`
-}
-
-// CallbackGame is a placeholder, currently holds no information.
-type CallbackGame struct{}
-
-// ForceReply represents a placeholder to force a reply from the user.
-type ForceReply struct {
-	ForceReply             bool   `json:"force_reply"`
-	InputFieldPlaceholder  string `json:"input_field_placeholder,omitempty"`
-	Selective              bool   `json:"selective,omitempty"`
-}
+func (*InlineKeyboardMarkup) replyMarkup() {}
+func (*ReplyKeyboardMarkup) replyMarkup()  {}
+func (*ReplyKeyboardRemove) replyMarkup()  {}
+func (*ForceReply) replyMarkup()           {}
 
 // NewInlineKeyboardMarkup creates a new inline keyboard markup.
 func NewInlineKeyboardMarkup(buttons ...[]InlineKeyboardButton) *InlineKeyboardMarkup {
@@ -99,6 +41,111 @@ func NewInlineKeyboardButtonCallback(text, callbackData string) InlineKeyboardBu
 	}
 }
 
+// NewInlineKeyboardButtonWebApp creates a button opening a Web App.
+func NewInlineKeyboardButtonWebApp(text, url string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:   text,
+		WebApp: &WebAppInfo{URL: url},
+	}
+}
+
+// NewInlineKeyboardButtonLoginURL creates a button authorizing the user on a website.
+func NewInlineKeyboardButtonLoginURL(text string, loginURL LoginURL) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:     text,
+		LoginURL: &loginURL,
+	}
+}
+
+// NewInlineKeyboardButtonSwitchInlineQuery creates a button inserting the bot's
+// username and query in the input field of a chat chosen by the user.
+func NewInlineKeyboardButtonSwitchInlineQuery(text, query string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:              text,
+		SwitchInlineQuery: &query,
+	}
+}
+
+// NewInlineKeyboardButtonSwitchInlineQueryCurrentChat creates a button inserting
+// the bot's username and query in the input field of the current chat.
+func NewInlineKeyboardButtonSwitchInlineQueryCurrentChat(text, query string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:                         text,
+		SwitchInlineQueryCurrentChat: &query,
+	}
+}
+
+// NewInlineKeyboardButtonSwitchInlineQueryChosenChat creates a button inserting
+// the bot's username and query in the input field of a chat of the allowed types.
+func NewInlineKeyboardButtonSwitchInlineQueryChosenChat(text string, chosenChat SwitchInlineQueryChosenChat) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:                        text,
+		SwitchInlineQueryChosenChat: &chosenChat,
+	}
+}
+
+// NewInlineKeyboardButtonCopyText creates a button copying copyText to the clipboard.
+func NewInlineKeyboardButtonCopyText(text, copyText string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:     text,
+		CopyText: &CopyTextButton{Text: copyText},
+	}
+}
+
+// NewInlineKeyboardButtonGame creates a button launching the game of the message.
+// It must be the first button of the first row.
+func NewInlineKeyboardButtonGame(text string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text:         text,
+		CallbackGame: &CallbackGame{},
+	}
+}
+
+// NewInlineKeyboardButtonPay creates the pay button of an invoice. It must be
+// the first button of the first row.
+func NewInlineKeyboardButtonPay(text string) InlineKeyboardButton {
+	return InlineKeyboardButton{
+		Text: text,
+		Pay:  true,
+	}
+}
+
+// NewKeyboardButton creates a reply keyboard button sending its text.
+func NewKeyboardButton(text string) KeyboardButton {
+	return KeyboardButton{Text: text}
+}
+
+// NewKeyboardButtonContact creates a button sending the user's phone number.
+func NewKeyboardButtonContact(text string) KeyboardButton {
+	return KeyboardButton{Text: text, RequestContact: true}
+}
+
+// NewKeyboardButtonLocation creates a button sending the user's location.
+func NewKeyboardButtonLocation(text string) KeyboardButton {
+	return KeyboardButton{Text: text, RequestLocation: true}
+}
+
+// NewKeyboardButtonPoll creates a button asking the user to create a poll of the
+// given type, "quiz" or "regular". An empty type allows both.
+func NewKeyboardButtonPoll(text, pollType string) KeyboardButton {
+	return KeyboardButton{Text: text, RequestPoll: &KeyboardButtonPollType{Type: pollType}}
+}
+
+// NewKeyboardButtonWebApp creates a button opening a Web App.
+func NewKeyboardButtonWebApp(text, url string) KeyboardButton {
+	return KeyboardButton{Text: text, WebApp: &WebAppInfo{URL: url}}
+}
+
+// NewKeyboardButtonRequestUsers creates a button asking the user to pick users.
+func NewKeyboardButtonRequestUsers(text string, request KeyboardButtonRequestUsers) KeyboardButton {
+	return KeyboardButton{Text: text, RequestUsers: &request}
+}
+
+// NewKeyboardButtonRequestChat creates a button asking the user to pick a chat.
+func NewKeyboardButtonRequestChat(text string, request KeyboardButtonRequestChat) KeyboardButton {
+	return KeyboardButton{Text: text, RequestChat: &request}
+}
+
 // NewReplyKeyboardMarkup creates a new reply keyboard markup.
 func NewReplyKeyboardMarkup(keyboard [][]KeyboardButton, opts ...ReplyKeyboardOption) *ReplyKeyboardMarkup {
 	markup := &ReplyKeyboardMarkup{
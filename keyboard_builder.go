@@ -0,0 +1,342 @@
+package gotelegrambot
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxCallbackDataLength is the maximum length of callback data in bytes.
+	MaxCallbackDataLength = 64
+
+	// MaxInlineKeyboardRowButtons is the maximum number of buttons in a row of an inline keyboard.
+	MaxInlineKeyboardRowButtons = 8
+
+	// MaxInlineKeyboardButtons is the maximum number of buttons in an inline keyboard.
+	MaxInlineKeyboardButtons = 100
+
+	// MaxReplyKeyboardRowButtons is the maximum number of buttons in a row of a reply keyboard.
+	MaxReplyKeyboardRowButtons = 12
+
+	// MaxReplyKeyboardButtons is the maximum number of buttons in a reply keyboard.
+	MaxReplyKeyboardButtons = 300
+)
+
+// keyboardLayout lays out buttons in rows for the keyboard builders.
+type keyboardLayout[T any] struct {
+	rows [][]T
+	wrap int
+
+	// open reports whether Add may append to the last row
+	open bool
+}
+
+// add appends buttons to the last row, starting a new one when it is closed
+// or holds wrap buttons.
+func (l *keyboardLayout[T]) add(buttons []T) {
+	for _, button := range buttons {
+		if !l.open || (l.wrap > 0 && len(l.rows[len(l.rows)-1]) >= l.wrap) {
+			l.rows = append(l.rows, nil)
+			l.open = true
+		}
+		last := len(l.rows) - 1
+		l.rows[last] = append(l.rows[last], button)
+	}
+}
+
+// row appends a complete row.
+func (l *keyboardLayout[T]) row(buttons []T) {
+	l.open = false
+	if len(buttons) == 0 {
+		return
+	}
+	l.rows = append(l.rows, append([]T(nil), buttons...))
+}
+
+// grid appends rows of columns buttons, the last one holding the rest.
+func (l *keyboardLayout[T]) grid(columns int, buttons []T) {
+	l.open = false
+	if columns <= 0 {
+		columns = len(buttons)
+	}
+	for len(buttons) > 0 {
+		n := columns
+		if n > len(buttons) {
+			n = len(buttons)
+		}
+		l.row(buttons[:n])
+		buttons = buttons[n:]
+	}
+}
+
+// InlineKeyboardBuilder builds an InlineKeyboardMarkup. Buttons added with Add
+// fill the current row, which is wrapped after the number of buttons set with
+// Wrap; Row and Grid add complete rows. Build validates the keyboard.
+type InlineKeyboardBuilder struct {
+	layout keyboardLayout[InlineKeyboardButton]
+}
+
+// NewInlineKeyboardBuilder creates an empty InlineKeyboardBuilder.
+func NewInlineKeyboardBuilder() *InlineKeyboardBuilder {
+	return &InlineKeyboardBuilder{}
+}
+
+// Wrap sets the number of buttons after which Add starts a new row. Zero, the
+// default, keeps adding to the current row.
+func (k *InlineKeyboardBuilder) Wrap(n int) *InlineKeyboardBuilder {
+	k.layout.wrap = n
+	return k
+}
+
+// Add adds buttons to the current row.
+func (k *InlineKeyboardBuilder) Add(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	k.layout.add(buttons)
+	return k
+}
+
+// AddIf adds buttons to the current row if condition is true.
+func (k *InlineKeyboardBuilder) AddIf(condition bool, buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	if condition {
+		k.layout.add(buttons)
+	}
+	return k
+}
+
+// Row adds a row of buttons. Buttons added afterwards start a new row.
+func (k *InlineKeyboardBuilder) Row(buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	k.layout.row(buttons)
+	return k
+}
+
+// RowIf adds a row of buttons if condition is true.
+func (k *InlineKeyboardBuilder) RowIf(condition bool, buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	if condition {
+		k.layout.row(buttons)
+	}
+	return k
+}
+
+// NewRow ends the current row.
+func (k *InlineKeyboardBuilder) NewRow() *InlineKeyboardBuilder {
+	k.layout.open = false
+	return k
+}
+
+// Grid adds buttons in rows of the given number of columns.
+func (k *InlineKeyboardBuilder) Grid(columns int, buttons ...InlineKeyboardButton) *InlineKeyboardBuilder {
+	k.layout.grid(columns, buttons)
+	return k
+}
+
+// Build returns the validated keyboard.
+func (k *InlineKeyboardBuilder) Build() (*InlineKeyboardMarkup, error) {
+	markup := NewInlineKeyboardMarkup(k.layout.rows...)
+	if err := markup.Validate(); err != nil {
+		return nil, err
+	}
+	return markup, nil
+}
+
+// Validate checks the keyboard against the limits of Telegram: every button
+// has exactly one action, callback data fits in MaxCallbackDataLength bytes,
+// pay and game buttons come first, and rows and the keyboard hold at most
+// MaxInlineKeyboardRowButtons and MaxInlineKeyboardButtons buttons.
+func (m *InlineKeyboardMarkup) Validate() error {
+	total := 0
+	for i, row := range m.InlineKeyboard {
+		if err := validateRow(i, len(row), MaxInlineKeyboardRowButtons); err != nil {
+			return err
+		}
+		for j, button := range row {
+			if err := validateInlineKeyboardButton(button, i == 0 && j == 0); err != nil {
+				return errors.Wrapf(err, "row %d, button %d", i+1, j+1)
+			}
+		}
+		total += len(row)
+	}
+
+	if total > MaxInlineKeyboardButtons {
+		return errors.Errorf("keyboard has %d buttons, more than %d", total, MaxInlineKeyboardButtons)
+	}
+	return nil
+}
+
+// validateRow checks the number of buttons of row i.
+func validateRow(i, buttons, max int) error {
+	switch {
+	case buttons == 0:
+		return errors.Errorf("row %d is empty", i+1)
+	case buttons > max:
+		return errors.Errorf("row %d has %d buttons, more than %d", i+1, buttons, max)
+	}
+	return nil
+}
+
+// validateInlineKeyboardButton checks a button, first telling whether it is
+// the first button of the keyboard.
+func validateInlineKeyboardButton(button InlineKeyboardButton, first bool) error {
+	if button.Text == "" {
+		return errors.New("text is required")
+	}
+
+	var actions []string
+	for _, action := range []struct {
+		name string
+		set  bool
+	}{
+		{"url", button.URL != ""},
+		{"callback_data", button.CallbackData != ""},
+		{"web_app", button.WebApp != nil},
+		{"login_url", button.LoginURL != nil},
+		{"switch_inline_query", button.SwitchInlineQuery != nil},
+		{"switch_inline_query_current_chat", button.SwitchInlineQueryCurrentChat != nil},
+		{"switch_inline_query_chosen_chat", button.SwitchInlineQueryChosenChat != nil},
+		{"copy_text", button.CopyText != nil},
+		{"callback_game", button.CallbackGame != nil},
+		{"pay", button.Pay},
+	} {
+		if action.set {
+			actions = append(actions, action.name)
+		}
+	}
+
+	switch {
+	case len(actions) == 0:
+		return errors.New("no action is set")
+	case len(actions) > 1:
+		return errors.Errorf("only one action is allowed, got %s", strings.Join(actions, ", "))
+	case len(button.CallbackData) > MaxCallbackDataLength:
+		return errors.Errorf("callback data is %d bytes, more than %d", len(button.CallbackData), MaxCallbackDataLength)
+	case !first && (button.Pay || button.CallbackGame != nil):
+		return errors.Errorf("%s button must be the first button of the first row", actions[0])
+	}
+	return nil
+}
+
+// ReplyKeyboardBuilder builds a ReplyKeyboardMarkup with the same layout
+// methods as InlineKeyboardBuilder.
+type ReplyKeyboardBuilder struct {
+	layout keyboardLayout[KeyboardButton]
+}
+
+// NewReplyKeyboardBuilder creates an empty ReplyKeyboardBuilder.
+func NewReplyKeyboardBuilder() *ReplyKeyboardBuilder {
+	return &ReplyKeyboardBuilder{}
+}
+
+// Wrap sets the number of buttons after which Add starts a new row. Zero, the
+// default, keeps adding to the current row.
+func (k *ReplyKeyboardBuilder) Wrap(n int) *ReplyKeyboardBuilder {
+	k.layout.wrap = n
+	return k
+}
+
+// Add adds buttons to the current row.
+func (k *ReplyKeyboardBuilder) Add(buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	k.layout.add(buttons)
+	return k
+}
+
+// AddIf adds buttons to the current row if condition is true.
+func (k *ReplyKeyboardBuilder) AddIf(condition bool, buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	if condition {
+		k.layout.add(buttons)
+	}
+	return k
+}
+
+// Row adds a row of buttons. Buttons added afterwards start a new row.
+func (k *ReplyKeyboardBuilder) Row(buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	k.layout.row(buttons)
+	return k
+}
+
+// RowIf adds a row of buttons if condition is true.
+func (k *ReplyKeyboardBuilder) RowIf(condition bool, buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	if condition {
+		k.layout.row(buttons)
+	}
+	return k
+}
+
+// NewRow ends the current row.
+func (k *ReplyKeyboardBuilder) NewRow() *ReplyKeyboardBuilder {
+	k.layout.open = false
+	return k
+}
+
+// Grid adds buttons in rows of the given number of columns.
+func (k *ReplyKeyboardBuilder) Grid(columns int, buttons ...KeyboardButton) *ReplyKeyboardBuilder {
+	k.layout.grid(columns, buttons)
+	return k
+}
+
+// Build returns the validated keyboard configured by opts.
+func (k *ReplyKeyboardBuilder) Build(opts ...ReplyKeyboardOption) (*ReplyKeyboardMarkup, error) {
+	markup := NewReplyKeyboardMarkup(k.layout.rows, opts...)
+	if err := markup.Validate(); err != nil {
+		return nil, err
+	}
+	return markup, nil
+}
+
+// Validate checks the keyboard against the limits of Telegram: it is not
+// empty, every button has at most one request, and rows and the keyboard hold
+// at most MaxReplyKeyboardRowButtons and MaxReplyKeyboardButtons buttons.
+func (m *ReplyKeyboardMarkup) Validate() error {
+	if len(m.Keyboard) == 0 {
+		return errors.New("keyboard is empty")
+	}
+
+	total := 0
+	for i, row := range m.Keyboard {
+		if err := validateRow(i, len(row), MaxReplyKeyboardRowButtons); err != nil {
+			return err
+		}
+		for j, button := range row {
+			if err := validateKeyboardButton(button); err != nil {
+				return errors.Wrapf(err, "row %d, button %d", i+1, j+1)
+			}
+		}
+		total += len(row)
+	}
+
+	if total > MaxReplyKeyboardButtons {
+		return errors.Errorf("keyboard has %d buttons, more than %d", total, MaxReplyKeyboardButtons)
+	}
+	return nil
+}
+
+// validateKeyboardButton checks a reply keyboard button.
+func validateKeyboardButton(button KeyboardButton) error {
+	if button.Text == "" {
+		return errors.New("text is required")
+	}
+
+	var requests []string
+	for _, request := range []struct {
+		name string
+		set  bool
+	}{
+		{"request_users", button.RequestUsers != nil},
+		{"request_chat", button.RequestChat != nil},
+		{"request_contact", button.RequestContact},
+		{"request_location", button.RequestLocation},
+		{"request_poll", button.RequestPoll != nil},
+		{"web_app", button.WebApp != nil},
+	} {
+		if request.set {
+			requests = append(requests, request.name)
+		}
+	}
+
+	switch {
+	case len(requests) > 1:
+		return errors.Errorf("only one action is allowed, got %s", strings.Join(requests, ", "))
+	case button.RequestUsers != nil && button.RequestUsers.MaxQuantity != nil &&
+		(*button.RequestUsers.MaxQuantity < 1 || *button.RequestUsers.MaxQuantity > 10):
+		return errors.Errorf("request_users max_quantity must be between 1 and 10, got %d", *button.RequestUsers.MaxQuantity)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package gotelegrambot
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInlineKeyboardBuilderLayout(t *testing.T) {
+	var buttons []InlineKeyboardButton
+	for i := 1; i <= 5; i++ {
+		buttons = append(buttons, NewInlineKeyboardButtonCallback(strconv.Itoa(i), "n:"+strconv.Itoa(i)))
+	}
+
+	isAdmin := false
+	markup, err := NewInlineKeyboardBuilder().
+		Wrap(2).
+		Add(buttons...).
+		AddIf(isAdmin, NewInlineKeyboardButtonCallback("Delete", "delete")).
+		Row(NewInlineKeyboardButtonURL("Docs", "https://example.com")).
+		Grid(3, buttons...).
+		Add(NewInlineKeyboardButtonCopyText("Copy", "code")).
+		NewRow().
+		Add(NewInlineKeyboardButtonSwitchInlineQuery("Share", "")).
+		Build()
+	require.NoError(t, err)
+
+	var widths []int
+	for _, row := range markup.InlineKeyboard {
+		widths = append(widths, len(row))
+	}
+	assert.Equal(t, []int{2, 2, 1, 1, 3, 2, 1, 1}, widths)
+
+	data, err := json.Marshal(markup.InlineKeyboard[7][0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text":"Share","switch_inline_query":""}`, string(data))
+}
+
+func TestInlineKeyboardValidation(t *testing.T) {
+	pay := NewInlineKeyboardButtonPay("Pay")
+	_, err := NewInlineKeyboardBuilder().Add(pay, NewInlineKeyboardButtonURL("Terms", "https://example.com")).Build()
+	assert.NoError(t, err)
+
+	for _, tc := range []struct {
+		name    string
+		builder *InlineKeyboardBuilder
+		err     string
+	}{
+		{
+			"no action",
+			NewInlineKeyboardBuilder().Add(InlineKeyboardButton{Text: "Nothing"}),
+			"row 1, button 1: no action is set",
+		},
+		{
+			"two actions",
+			NewInlineKeyboardBuilder().Add(InlineKeyboardButton{Text: "Both", URL: "https://example.com", CallbackData: "x"}),
+			"row 1, button 1: only one action is allowed, got url, callback_data",
+		},
+		{
+			"long callback data",
+			NewInlineKeyboardBuilder().Add(NewInlineKeyboardButtonCallback("Long", strings.Repeat("x", 65))),
+			"row 1, button 1: callback data is 65 bytes, more than 64",
+		},
+		{
+			"pay not first",
+			NewInlineKeyboardBuilder().Add(NewInlineKeyboardButtonURL("Terms", "https://example.com"), pay),
+			"row 1, button 2: pay button must be the first button of the first row",
+		},
+		{
+			"wide row",
+			NewInlineKeyboardBuilder().Add(make([]InlineKeyboardButton, 9)...),
+			"row 1 has 9 buttons, more than 8",
+		},
+	} {
+		_, err := tc.builder.Build()
+		assert.EqualError(t, err, tc.err, tc.name)
+	}
+
+	builder := NewInlineKeyboardBuilder()
+	for i := 0; i < 101; i++ {
+		builder.Grid(1, NewInlineKeyboardButtonCallback("x", "x"))
+	}
+	_, err = builder.Build()
+	assert.EqualError(t, err, "keyboard has 101 buttons, more than 100")
+}
+
+func TestReplyKeyboardBuilder(t *testing.T) {
+	markup, err := NewReplyKeyboardBuilder().
+		Wrap(2).
+		Add(NewKeyboardButton("One"), NewKeyboardButton("Two"), NewKeyboardButtonContact("Phone")).
+		Row(NewKeyboardButtonRequestUsers("Pick friends", KeyboardButtonRequestUsers{RequestID: 1, MaxQuantity: Ptr(3)})).
+		Row(NewKeyboardButtonRequestChat("Pick a channel", KeyboardButtonRequestChat{RequestID: 2, ChatIsChannel: true})).
+		Build(WithResizeKeyboard(true))
+	require.NoError(t, err)
+	assert.Len(t, markup.Keyboard, 4)
+	assert.True(t, markup.ResizeKeyboard)
+
+	data, err := json.Marshal(markup.Keyboard[3][0])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"text":"Pick a channel","request_chat":{"request_id":2,"chat_is_channel":true}}`, string(data))
+
+	_, err = NewReplyKeyboardBuilder().Build()
+	assert.EqualError(t, err, "keyboard is empty")
+
+	_, err = NewReplyKeyboardBuilder().Add(KeyboardButton{Text: "Both", RequestContact: true, RequestLocation: true}).Build()
+	assert.EqualError(t, err, "row 1, button 1: only one action is allowed, got request_contact, request_location")
+
+	_, err = NewReplyKeyboardBuilder().Add(NewKeyboardButtonRequestUsers("Pick", KeyboardButtonRequestUsers{MaxQuantity: Ptr(11)})).Build()
+	assert.EqualError(t, err, "row 1, button 1: request_users max_quantity must be between 1 and 10, got 11")
+}
@@ -0,0 +1,178 @@
+package gotelegrambot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogLevel is the severity of a log entry.
+type LogLevel int
+
+// Log levels, from the most to the least verbose. LogLevelTrace adds the raw
+// request and response bodies to the debug output.
+const (
+	LogLevelTrace LogLevel = iota
+	LogLevelDebug
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+// String returns the name of the level.
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelTrace:
+		return "TRACE"
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LEVEL(%d)", int(l))
+}
+
+// Logger receives structured log entries from the bot. Entries carry
+// alternating key and value pairs, such as "method", "sendMessage".
+type Logger interface {
+	// Enabled reports whether entries of the level are logged.
+	Enabled(level LogLevel) bool
+	// Log writes an entry.
+	Log(level LogLevel, msg string, keysAndValues ...interface{})
+}
+
+// textLogger writes entries as single key=value lines.
+type textLogger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	level LogLevel
+}
+
+// NewLogger creates a Logger writing entries of at least the given level to w.
+func NewLogger(w io.Writer, level LogLevel) Logger {
+	return &textLogger{w: w, level: level}
+}
+
+// Enabled reports whether entries of the level are logged.
+func (l *textLogger) Enabled(level LogLevel) bool {
+	return level >= l.level
+}
+
+// Log writes an entry.
+func (l *textLogger) Log(level LogLevel, msg string, keysAndValues ...interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(time.Now().Format(time.RFC3339))
+	sb.WriteByte(' ')
+	sb.WriteString(level.String())
+	sb.WriteByte(' ')
+	sb.WriteString(msg)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		var value interface{} = "(missing)"
+		if i+1 < len(keysAndValues) {
+			value = keysAndValues[i+1]
+		}
+		fmt.Fprintf(&sb, " %v=%q", keysAndValues[i], fmt.Sprint(value))
+	}
+	sb.WriteByte('\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	io.WriteString(l.w, sb.String())
+}
+
+// debugLogger is used when Debug is set and no logger was configured.
+var debugLogger = NewLogger(os.Stdout, LogLevelDebug)
+
+// WithLogger sets the logger the bot writes its structured logs to.
+func WithLogger(logger Logger) BotOption {
+	return func(b *Bot) {
+		b.logger = logger
+	}
+}
+
+// logEnabled reports whether the bot logs entries of the level.
+func (b *Bot) logEnabled(level LogLevel) bool {
+	logger := b.currentLogger()
+	return logger != nil && logger.Enabled(level)
+}
+
+func (b *Bot) currentLogger() Logger {
+	if b.logger != nil {
+		return b.logger
+	}
+	if b.Debug {
+		return debugLogger
+	}
+	return nil
+}
+
+// log writes an entry with the bot token redacted from the message and values.
+func (b *Bot) log(level LogLevel, msg string, keysAndValues ...interface{}) {
+	logger := b.currentLogger()
+	if logger == nil || !logger.Enabled(level) {
+		return
+	}
+
+	redacted := make([]interface{}, len(keysAndValues))
+	for i, value := range keysAndValues {
+		switch v := value.(type) {
+		case string:
+			redacted[i] = b.redact(v)
+		case error:
+			redacted[i] = b.redact(v.Error())
+		default:
+			redacted[i] = value
+		}
+	}
+
+	logger.Log(level, b.redact(msg), redacted...)
+}
+
+// redact replaces the bot token in s.
+func (b *Bot) redact(s string) string {
+	if b.Token == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, b.Token, "<token>")
+}
+
+// redactError removes the bot token from the URL carried by HTTP client errors.
+func (b *Bot) redactError(err error) error {
+	urlErr, ok := err.(*url.Error)
+	if !ok {
+		return err
+	}
+
+	return &url.Error{
+		Op:  urlErr.Op,
+		URL: b.redact(urlErr.URL),
+		Err: urlErr.Err,
+	}
+}
+
+// redactJSON returns the JSON body of a request with secret params redacted.
+func redactJSON(params interface{}, data []byte) []byte {
+	redacted := redactParams(params)
+	if redacted == nil {
+		return data
+	}
+
+	out, err := json.Marshal(redacted)
+	if err != nil {
+		return data
+	}
+	return out
+}
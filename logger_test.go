@@ -0,0 +1,50 @@
+package gotelegrambot
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggerRedactsToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	bot, _ := New("123:secret", WithLogger(NewLogger(&buf, LogLevelTrace)))
+	bot.APIEndpoint = server.URL + "/bot123:secret"
+
+	_, err := bot.SendMessage(context.Background(), NewChatID(1), "hello")
+	require.NoError(t, err)
+
+	logs := buf.String()
+	assert.Contains(t, logs, `method="sendMessage"`)
+	assert.Contains(t, logs, "request body")
+	assert.Contains(t, logs, "response body")
+
+	// Errors from the HTTP client carry the URL without the token
+	bot.APIEndpoint = "http://127.0.0.1:0/bot123:secret"
+	bot.retryCount = 0
+	_, err = bot.SendMessage(context.Background(), NewChatID(1), "hello")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.NotContains(t, buf.String(), "secret")
+}
+
+func TestLoggerLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(&buf, LogLevelInfo)
+	logger.Log(LogLevelDebug, "hidden")
+	logger.Log(LogLevelWarn, "shown", "key", "value")
+
+	assert.False(t, strings.Contains(buf.String(), "hidden"))
+	assert.Contains(t, buf.String(), `WARN shown key="value"`)
+}
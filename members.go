@@ -0,0 +1,275 @@
+package gotelegrambot
+
+import (
+	"context"
+)
+
+// Update types that can be requested with allowed_updates.
+const (
+	UpdateTypeMessage                 = "message"
+	UpdateTypeEditedMessage           = "edited_message"
+	UpdateTypeChannelPost             = "channel_post"
+	UpdateTypeEditedChannelPost       = "edited_channel_post"
+	UpdateTypeBusinessConnection      = "business_connection"
+	UpdateTypeBusinessMessage         = "business_message"
+	UpdateTypeEditedBusinessMessage   = "edited_business_message"
+	UpdateTypeDeletedBusinessMessages = "deleted_business_messages"
+	UpdateTypeMessageReaction         = "message_reaction"
+	UpdateTypeMessageReactionCount    = "message_reaction_count"
+	UpdateTypeInlineQuery             = "inline_query"
+	UpdateTypeChosenInlineResult      = "chosen_inline_result"
+	UpdateTypeCallbackQuery           = "callback_query"
+	UpdateTypeShippingQuery           = "shipping_query"
+	UpdateTypePreCheckoutQuery        = "pre_checkout_query"
+	UpdateTypePurchasedPaidMedia      = "purchased_paid_media"
+	UpdateTypePoll                    = "poll"
+	UpdateTypePollAnswer              = "poll_answer"
+	UpdateTypeMyChatMember            = "my_chat_member"
+	UpdateTypeChatMember              = "chat_member"
+	UpdateTypeChatJoinRequest         = "chat_join_request"
+	UpdateTypeChatBoost               = "chat_boost"
+	UpdateTypeRemovedChatBoost        = "removed_chat_boost"
+)
+
+// defaultUpdateTypes are the update types Telegram sends when allowed_updates
+// is empty: every type except chat_member, message_reaction and
+// message_reaction_count.
+var defaultUpdateTypes = []string{
+	UpdateTypeMessage,
+	UpdateTypeEditedMessage,
+	UpdateTypeChannelPost,
+	UpdateTypeEditedChannelPost,
+	UpdateTypeBusinessConnection,
+	UpdateTypeBusinessMessage,
+	UpdateTypeEditedBusinessMessage,
+	UpdateTypeDeletedBusinessMessages,
+	UpdateTypeInlineQuery,
+	UpdateTypeChosenInlineResult,
+	UpdateTypeCallbackQuery,
+	UpdateTypeShippingQuery,
+	UpdateTypePreCheckoutQuery,
+	UpdateTypePurchasedPaidMedia,
+	UpdateTypePoll,
+	UpdateTypePollAnswer,
+	UpdateTypeMyChatMember,
+	UpdateTypeChatJoinRequest,
+	UpdateTypeChatBoost,
+	UpdateTypeRemovedChatBoost,
+}
+
+// MemberChange classifies a change in the status of a chat member.
+type MemberChange int
+
+// Kinds of chat member changes.
+const (
+	MemberChangeUpdated MemberChange = iota
+	MemberChangeJoined
+	MemberChangeLeft
+	MemberChangePromoted
+	MemberChangeDemoted
+	MemberChangeRestricted
+	MemberChangeUnrestricted
+	MemberChangeBanned
+	MemberChangeUnbanned
+)
+
+// String returns the name of the change.
+func (c MemberChange) String() string {
+	switch c {
+	case MemberChangeJoined:
+		return "joined"
+	case MemberChangeLeft:
+		return "left"
+	case MemberChangePromoted:
+		return "promoted"
+	case MemberChangeDemoted:
+		return "demoted"
+	case MemberChangeRestricted:
+		return "restricted"
+	case MemberChangeUnrestricted:
+		return "unrestricted"
+	case MemberChangeBanned:
+		return "banned"
+	case MemberChangeUnbanned:
+		return "unbanned"
+	}
+	return "updated"
+}
+
+// Change classifies the update by comparing the old and new member state.
+// MemberChangeUpdated is returned when only the rights or restrictions changed.
+func (u *ChatMemberUpdated) Change() MemberChange {
+	oldMember, newMember := u.OldChatMember, u.NewChatMember
+	if oldMember == nil {
+		oldMember = &ChatMember{}
+	}
+	if newMember == nil {
+		newMember = &ChatMember{}
+	}
+
+	switch {
+	case newMember.IsBanned() && !oldMember.IsBanned():
+		return MemberChangeBanned
+	case oldMember.IsMember() && !newMember.IsMember():
+		return MemberChangeLeft
+	case !oldMember.IsMember() && newMember.IsMember():
+		return MemberChangeJoined
+	case !oldMember.IsMember():
+		if oldMember.IsBanned() && !newMember.IsBanned() {
+			return MemberChangeUnbanned
+		}
+		return MemberChangeUpdated
+	case newMember.IsAdministrator() && !oldMember.IsAdministrator():
+		return MemberChangePromoted
+	case oldMember.IsAdministrator() && !newMember.IsAdministrator():
+		return MemberChangeDemoted
+	case newMember.IsRestricted() && !oldMember.IsRestricted():
+		return MemberChangeRestricted
+	case oldMember.IsRestricted() && !newMember.IsRestricted():
+		return MemberChangeUnrestricted
+	}
+	return MemberChangeUpdated
+}
+
+// ChatMemberHandler is a function that handles a change in the status of a chat member.
+type ChatMemberHandler func(ctx context.Context, update *ChatMemberUpdated) error
+
+// chatMemberHook is a ChatMemberHandler registered for one kind of update.
+type chatMemberHook struct {
+	updateType string
+	match      func(update *ChatMemberUpdated) bool
+	handler    ChatMemberHandler
+}
+
+// OnMyChatMember registers a handler for every change of the bot's own status in a chat.
+func (b *Bot) OnMyChatMember(handler ChatMemberHandler) {
+	b.addChatMemberHook(UpdateTypeMyChatMember, nil, handler)
+}
+
+// OnChatMember registers a handler for every change of a member's status in a chat.
+// Telegram sends these updates only when the bot is an administrator in the chat.
+func (b *Bot) OnChatMember(handler ChatMemberHandler) {
+	b.addChatMemberHook(UpdateTypeChatMember, nil, handler)
+}
+
+// OnBotAddedToGroup registers a handler called when the bot is added to a group or a supergroup.
+func (b *Bot) OnBotAddedToGroup(handler ChatMemberHandler) {
+	b.addChatMemberHook(UpdateTypeMyChatMember, func(update *ChatMemberUpdated) bool {
+		return update.Chat != nil &&
+			(update.Chat.Type == "group" || update.Chat.Type == "supergroup") &&
+			update.Change() == MemberChangeJoined
+	}, handler)
+}
+
+// OnBotKicked registers a handler called when the bot is removed from a chat,
+// or blocked by the user in a private chat.
+func (b *Bot) OnBotKicked(handler ChatMemberHandler) {
+	b.addChatMemberHook(UpdateTypeMyChatMember, func(update *ChatMemberUpdated) bool {
+		change := update.Change()
+		return change == MemberChangeLeft || change == MemberChangeBanned
+	}, handler)
+}
+
+// OnUserJoined registers a handler called when a user joins a chat.
+func (b *Bot) OnUserJoined(handler ChatMemberHandler) {
+	b.addChatMemberHook(UpdateTypeChatMember, func(update *ChatMemberUpdated) bool {
+		return update.Change() == MemberChangeJoined
+	}, handler)
+}
+
+// OnUserLeft registers a handler called when a user leaves or is removed from a chat.
+func (b *Bot) OnUserLeft(handler ChatMemberHandler) {
+	b.addChatMemberHook(UpdateTypeChatMember, func(update *ChatMemberUpdated) bool {
+		change := update.Change()
+		return change == MemberChangeLeft || change == MemberChangeBanned
+	}, handler)
+}
+
+func (b *Bot) addChatMemberHook(updateType string, match func(*ChatMemberUpdated) bool, handler ChatMemberHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.chatMemberHooks = append(b.chatMemberHooks, chatMemberHook{
+		updateType: updateType,
+		match:      match,
+		handler:    handler,
+	})
+}
+
+// runChatMemberHooks calls the registered hooks matching the update.
+func (b *Bot) runChatMemberHooks(ctx context.Context, update *Update) error {
+	b.mu.RLock()
+	hooks := b.chatMemberHooks
+	b.mu.RUnlock()
+
+	for _, hook := range hooks {
+		var changed *ChatMemberUpdated
+		switch hook.updateType {
+		case UpdateTypeMyChatMember:
+			changed = update.MyChatMember
+		case UpdateTypeChatMember:
+			changed = update.ChatMember
+		}
+
+		if changed == nil || (hook.match != nil && !hook.match(changed)) {
+			continue
+		}
+
+		if err := hook.handler(ctx, changed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// allowedUpdates adds the update types needed by the registered hooks and
+// routes to the configured ones. An empty list stands for Telegram's defaults,
+// so it is expanded before anything is added to it.
+func (b *Bot) allowedUpdates(configured []string) []string {
+	b.mu.RLock()
+	hooks := b.chatMemberHooks
+	joinRequestHooks := b.joinRequestHooks
+	callbackRoutes := b.callbackRoutes
+	b.mu.RUnlock()
+
+	var required []string
+	for _, hook := range hooks {
+		required = append(required, hook.updateType)
+	}
+	if len(joinRequestHooks) > 0 {
+		required = append(required, UpdateTypeChatJoinRequest)
+	}
+	if len(callbackRoutes) > 0 {
+		required = append(required, UpdateTypeCallbackQuery)
+	}
+	if len(required) == 0 {
+		return configured
+	}
+
+	base := configured
+	if len(base) == 0 {
+		base = defaultUpdateTypes
+	}
+
+	allowed := append([]string{}, base...)
+	for _, updateType := range required {
+		if !containsString(allowed, updateType) {
+			allowed = append(allowed, updateType)
+		}
+	}
+
+	if len(configured) == 0 && len(allowed) == len(defaultUpdateTypes) {
+		return configured
+	}
+	return allowed
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -2,175 +2,135 @@ package gotelegrambot
 
 import (
 	"context"
-	"fmt"
 )
 
 // SendMessage sends a text message.
-func (b *Bot) SendMessage(ctx context.Context, chatID interface{}, text string, options ...SendMessageOption) (*Message, error) {
-	params := map[string]interface{}{
-		"chat_id": chatID,
-		"text":    text,
+func (b *Bot) SendMessage(ctx context.Context, chatID ChatID, text string, options ...SendMessageOption) (*Message, error) {
+	params := SendMessageParams{
+		ChatID: chatID,
+		Text:   text,
 	}
-	
-	opts := defaultSendMessageOptions()
 	for _, opt := range options {
-		opt(&opts)
+		opt(&params)
 	}
-	
-	if opts.ParseMode != "" {
-		params["parse_mode"] = opts.ParseMode
-	}
-	
-	if len(opts.Entities) > 0 {
-		params["entities"] = opts.Entities
-	}
-	
-	if opts.DisableWebPagePreview {
-		params["disable_web_page_preview"] = true
-	}
-	
-	if opts.DisableNotification {
-		params["disable_notification"] = true
-	}
-	
-	if opts.ProtectContent {
-		params["protect_content"] = true
-	}
-	
-	if opts.ReplyToMessageID != 0 {
-		params["reply_to_message_id"] = opts.ReplyToMessageID
-	}
-	
-	if opts.AllowSendingWithoutReply {
-		params["allow_sending_without_reply"] = true
-	}
-	
-	if opts.ReplyMarkup != nil {
-		params["reply_markup"] = opts.ReplyMarkup
-	}
-	
-	var message Message
-	// TODO: Make the actual API request and parse response
-	// This is a placeholder
-	return &message, nil
-}
+	dropEmptyReply(&params.ReplyParameters)
 
-// sendMessageOptions represents options for SendMessage.
-type sendMessageOptions struct {
-	ParseMode                string
-	Entities                 []MessageEntity
-	DisableWebPagePreview    bool
-	DisableNotification      bool
-	ProtectContent           bool
-	ReplyToMessageID         int
-	AllowSendingWithoutReply bool
-	ReplyMarkup              interface{}
+	return params.do(ctx, b)
 }
 
 // SendMessageOption is a function that configures SendMessage options.
-type SendMessageOption func(*sendMessageOptions)
+type SendMessageOption func(*SendMessageParams)
+
+// Parse modes supported by the Telegram API.
+const (
+	ParseModeHTML       = "HTML"
+	ParseModeMarkdown   = "Markdown"
+	ParseModeMarkdownV2 = "MarkdownV2"
+)
+
+// WithMessageThreadID sends the message to a forum topic. Forum supergroups only.
+func WithMessageThreadID(messageThreadID int) SendMessageOption {
+	return func(p *SendMessageParams) {
+		p.MessageThreadID = messageThreadID
+	}
+}
 
 // WithParseMode sets the parse mode for the message.
 func WithParseMode(parseMode string) SendMessageOption {
-	return func(o *sendMessageOptions) {
-		o.ParseMode = parseMode
+	return func(p *SendMessageParams) {
+		p.ParseMode = parseMode
 	}
 }
 
 // WithEntities sets the entities for the message.
 func WithEntities(entities []MessageEntity) SendMessageOption {
-	return func(o *sendMessageOptions) {
-		o.Entities = entities
+	return func(p *SendMessageParams) {
+		p.Entities = entities
 	}
 }
 
 // WithDisableWebPagePreview disables link previews.
 func WithDisableWebPagePreview(disable bool) SendMessageOption {
-	return func(o *sendMessageOptions) {
-		o.DisableWebPagePreview = disable
+	return func(p *SendMessageParams) {
+		if p.LinkPreviewOptions == nil {
+			p.LinkPreviewOptions = &LinkPreviewOptions{}
+		}
+		p.LinkPreviewOptions.IsDisabled = disable
 	}
 }
 
 // WithDisableNotification disables notifications for the message.
 func WithDisableNotification(disable bool) SendMessageOption {
-	return func(o *sendMessageOptions) {
-		o.DisableNotification = disable
+	return func(p *SendMessageParams) {
+		p.DisableNotification = disable
 	}
 }
 
 // WithProtectContent protects the content of the message.
 func WithProtectContent(protect bool) SendMessageOption {
-	return func(o *sendMessageOptions) {
-		o.ProtectContent = protect
+	return func(p *SendMessageParams) {
+		p.ProtectContent = protect
 	}
 }
 
 // WithReplyToMessageID sets the message to reply to.
 func WithReplyToMessageID(messageID int) SendMessageOption {
-	return func(o *sendMessageOptions) {
-		o.ReplyToMessageID = messageID
+	return func(p *SendMessageParams) {
+		replyParameters(&p.ReplyParameters).MessageID = messageID
 	}
 }
 
 // WithAllowSendingWithoutReply allows sending messages that are replies without the original message.
 func WithAllowSendingWithoutReply(allow bool) SendMessageOption {
-	return func(o *sendMessageOptions) {
-		o.AllowSendingWithoutReply = allow
+	return func(p *SendMessageParams) {
+		replyParameters(&p.ReplyParameters).AllowSendingWithoutReply = allow
 	}
 }
 
 // WithReplyMarkup sets the reply markup for the message.
-func WithReplyMarkup(markup interface{}) SendMessageOption {
-	return func(o *sendMessageOptions) {
-		o.ReplyMarkup = markup
+func WithReplyMarkup(markup ReplyMarkup) SendMessageOption {
+	return func(p *SendMessageParams) {
+		p.ReplyMarkup = markup
+	}
+}
+
+// replyParameters returns the reply parameters of a params struct, creating
+// them when needed.
+func replyParameters(reply **ReplyParameters) *ReplyParameters {
+	if *reply == nil {
+		*reply = &ReplyParameters{}
 	}
+	return *reply
 }
 
-func defaultSendMessageOptions() sendMessageOptions {
-	return sendMessageOptions{}
+// dropEmptyReply removes reply parameters set without a message to reply to,
+// such as by WithAllowSendingWithoutReply alone.
+func dropEmptyReply(reply **ReplyParameters) {
+	if *reply != nil && (*reply).MessageID == 0 {
+		*reply = nil
+	}
 }
 
-// SendPhoto sends a photo.
-func (b *Bot) SendPhoto(ctx context.Context, chatID interface{}, photo interface{}, options ...SendPhotoOption) (*Message, error) {
-	params := map[string]interface{}{
-		"chat_id": chatID,
-		"photo":   photo,
+// SendPhoto sends a photo. The photo is a file ID, an HTTP URL or a local
+// file:// path to upload.
+func (b *Bot) SendPhoto(ctx context.Context, chatID ChatID, photo interface{}, options ...SendPhotoOption) (*Message, error) {
+	params := SendPhotoParams{
+		ChatID: chatID,
+		Photo:  photo,
 	}
-	
-	opts := defaultSendPhotoOptions()
 	for _, opt := range options {
-		opt(&opts)
-	}
-	
-	// Fill in the params based on options
-	// ...
-	
-	var message Message
-	// TODO: Make the actual API request and parse response
-	return &message, nil
+		opt(&params)
+	}
+
+	return params.do(ctx, b)
 }
 
 // SendPhotoOption is a function that configures SendPhoto options.
-type SendPhotoOption func(*sendPhotoOptions)
-
-// sendPhotoOptions represents options for SendPhoto.
-type sendPhotoOptions struct {
-	Caption                  string
-	ParseMode                string
-	CaptionEntities          []MessageEntity
-	HasSpoiler               bool
-	DisableNotification      bool
-	ProtectContent           bool
-	ReplyToMessageID         int
-	AllowSendingWithoutReply bool
-	ReplyMarkup              interface{}
-}
+type SendPhotoOption func(*SendPhotoParams)
 
-func defaultSendPhotoOptions() sendPhotoOptions {
-	return sendPhotoOptions{}
+// WithPhotoMessageThreadID sends the photo to a forum topic. Forum supergroups only.
+func WithPhotoMessageThreadID(messageThreadID int) SendPhotoOption {
+	return func(p *SendPhotoParams) {
+		p.MessageThreadID = messageThreadID
+	}
 }
-
-// Additional message sending methods would be implemented here,
-// following the same pattern as SendMessage and SendPhoto.
-// This includes methods for sending videos, audio, documents,
-// locations, venues, contacts, etc.
\ No newline at end of file
@@ -0,0 +1,3452 @@
+// Code generated by botapigen from api/botapi.json (Bot API 7.11). DO NOT EDIT.
+
+package gotelegrambot
+
+import (
+	"context"
+)
+
+// AddStickerToSetParams represents the params of AddStickerToSet.
+type AddStickerToSetParams struct {
+	UserID  int64         `json:"user_id"`
+	Name    string        `json:"name"`
+	Sticker *InputSticker `json:"sticker"`
+}
+
+// Method returns "addStickerToSet".
+func (AddStickerToSetParams) Method() string {
+	return "addStickerToSet"
+}
+
+func (p AddStickerToSetParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "addStickerToSet", p, nil, nil)
+}
+
+func (p AddStickerToSetParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// AddStickerToSet adds a new sticker to a set created by the bot.
+func (b *Bot) AddStickerToSet(ctx context.Context, params AddStickerToSetParams) error {
+	return params.do(ctx, b)
+}
+
+// AnswerCallbackQueryParams represents the params of AnswerCallbackQuery.
+type AnswerCallbackQueryParams struct {
+	CallbackQueryID string `json:"callback_query_id"`
+	Text            string `json:"text,omitempty"`
+	ShowAlert       bool   `json:"show_alert,omitempty"`
+	URL             string `json:"url,omitempty"`
+	CacheTime       int    `json:"cache_time,omitempty"`
+}
+
+// Method returns "answerCallbackQuery".
+func (AnswerCallbackQueryParams) Method() string {
+	return "answerCallbackQuery"
+}
+
+func (p AnswerCallbackQueryParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "answerCallbackQuery", p, nil, nil)
+}
+
+func (p AnswerCallbackQueryParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// AnswerInlineQueryParams represents the params of AnswerInlineQuery.
+type AnswerInlineQueryParams struct {
+	InlineQueryID string                    `json:"inline_query_id"`
+	Results       []InlineQueryResult       `json:"results"`
+	CacheTime     *int                      `json:"cache_time,omitempty"`
+	IsPersonal    bool                      `json:"is_personal,omitempty"`
+	NextOffset    string                    `json:"next_offset,omitempty"`
+	Button        *InlineQueryResultsButton `json:"button,omitempty"`
+}
+
+// Method returns "answerInlineQuery".
+func (AnswerInlineQueryParams) Method() string {
+	return "answerInlineQuery"
+}
+
+func (p AnswerInlineQueryParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "answerInlineQuery", p, nil, nil)
+}
+
+func (p AnswerInlineQueryParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// AnswerPreCheckoutQueryParams represents the params of AnswerPreCheckoutQuery.
+type AnswerPreCheckoutQueryParams struct {
+	PreCheckoutQueryID string `json:"pre_checkout_query_id"`
+	OK                 bool   `json:"ok"`
+	ErrorMessage       string `json:"error_message,omitempty"`
+}
+
+// Method returns "answerPreCheckoutQuery".
+func (AnswerPreCheckoutQueryParams) Method() string {
+	return "answerPreCheckoutQuery"
+}
+
+func (p AnswerPreCheckoutQueryParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "answerPreCheckoutQuery", p, nil, nil)
+}
+
+func (p AnswerPreCheckoutQueryParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// AnswerShippingQueryParams represents the params of AnswerShippingQuery.
+type AnswerShippingQueryParams struct {
+	ShippingQueryID string           `json:"shipping_query_id"`
+	OK              bool             `json:"ok"`
+	ShippingOptions []ShippingOption `json:"shipping_options,omitempty"`
+	ErrorMessage    string           `json:"error_message,omitempty"`
+}
+
+// Method returns "answerShippingQuery".
+func (AnswerShippingQueryParams) Method() string {
+	return "answerShippingQuery"
+}
+
+func (p AnswerShippingQueryParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "answerShippingQuery", p, nil, nil)
+}
+
+func (p AnswerShippingQueryParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// AnswerWebAppQueryParams represents the params of AnswerWebAppQuery.
+type AnswerWebAppQueryParams struct {
+	WebAppQueryID string            `json:"web_app_query_id"`
+	Result        InlineQueryResult `json:"result"`
+}
+
+// Method returns "answerWebAppQuery".
+func (AnswerWebAppQueryParams) Method() string {
+	return "answerWebAppQuery"
+}
+
+func (p AnswerWebAppQueryParams) do(ctx context.Context, b *Bot) (*SentWebAppMessage, error) {
+	var result SentWebAppMessage
+	if err := b.makeParamsRequest(ctx, "answerWebAppQuery", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p AnswerWebAppQueryParams) call(ctx context.Context, b *Bot) (*SentWebAppMessage, error) {
+	return p.do(ctx, b)
+}
+
+// AnswerWebAppQuery sets the result of an interaction with a Web App and send a corresponding message on behalf of the user to the chat from which the query originated.
+func (b *Bot) AnswerWebAppQuery(ctx context.Context, params AnswerWebAppQueryParams) (*SentWebAppMessage, error) {
+	return params.do(ctx, b)
+}
+
+// ApproveChatJoinRequestParams represents the params of ApproveChatJoinRequest.
+type ApproveChatJoinRequestParams struct {
+	ChatID ChatID `json:"chat_id"`
+	UserID int64  `json:"user_id"`
+}
+
+// Method returns "approveChatJoinRequest".
+func (ApproveChatJoinRequestParams) Method() string {
+	return "approveChatJoinRequest"
+}
+
+func (p ApproveChatJoinRequestParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "approveChatJoinRequest", p, nil, nil)
+}
+
+func (p ApproveChatJoinRequestParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// BanChatMemberParams represents the params of BanChatMember.
+type BanChatMemberParams struct {
+	ChatID         ChatID `json:"chat_id"`
+	UserID         int64  `json:"user_id"`
+	UntilDate      int    `json:"until_date,omitempty"`
+	RevokeMessages bool   `json:"revoke_messages,omitempty"`
+}
+
+// Method returns "banChatMember".
+func (BanChatMemberParams) Method() string {
+	return "banChatMember"
+}
+
+func (p BanChatMemberParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "banChatMember", p, nil, nil)
+}
+
+func (p BanChatMemberParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// BanChatSenderChatParams represents the params of BanChatSenderChat.
+type BanChatSenderChatParams struct {
+	ChatID       ChatID `json:"chat_id"`
+	SenderChatID int64  `json:"sender_chat_id"`
+}
+
+// Method returns "banChatSenderChat".
+func (BanChatSenderChatParams) Method() string {
+	return "banChatSenderChat"
+}
+
+func (p BanChatSenderChatParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "banChatSenderChat", p, nil, nil)
+}
+
+func (p BanChatSenderChatParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// BanChatSenderChat bans a channel chat in a supergroup or a channel.
+func (b *Bot) BanChatSenderChat(ctx context.Context, params BanChatSenderChatParams) error {
+	return params.do(ctx, b)
+}
+
+// CloseParams represents the params of Close.
+type CloseParams struct{}
+
+// Method returns "close".
+func (CloseParams) Method() string {
+	return "close"
+}
+
+func (CloseParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "close", nil, nil, nil)
+}
+
+func (p CloseParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// Close closes the bot instance before moving it from one local server to another.
+func (b *Bot) Close(ctx context.Context) error {
+	return CloseParams{}.do(ctx, b)
+}
+
+// CloseForumTopicParams represents the params of CloseForumTopic.
+type CloseForumTopicParams struct {
+	ChatID          ChatID `json:"chat_id"`
+	MessageThreadID int    `json:"message_thread_id"`
+}
+
+// Method returns "closeForumTopic".
+func (CloseForumTopicParams) Method() string {
+	return "closeForumTopic"
+}
+
+func (p CloseForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "closeForumTopic", p, nil, nil)
+}
+
+func (p CloseForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// CloseGeneralForumTopicParams represents the params of CloseGeneralForumTopic.
+type CloseGeneralForumTopicParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "closeGeneralForumTopic".
+func (CloseGeneralForumTopicParams) Method() string {
+	return "closeGeneralForumTopic"
+}
+
+func (p CloseGeneralForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "closeGeneralForumTopic", p, nil, nil)
+}
+
+func (p CloseGeneralForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// CopyMessageParams represents the params of CopyMessage.
+type CopyMessageParams struct {
+	ChatID                ChatID           `json:"chat_id"`
+	MessageThreadID       int              `json:"message_thread_id,omitempty"`
+	FromChatID            ChatID           `json:"from_chat_id"`
+	MessageID             int              `json:"message_id"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             string           `json:"parse_mode,omitempty"`
+	CaptionEntities       []MessageEntity  `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	DisableNotification   bool             `json:"disable_notification,omitempty"`
+	ProtectContent        bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast    bool             `json:"allow_paid_broadcast,omitempty"`
+	ReplyParameters       *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup           ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "copyMessage".
+func (CopyMessageParams) Method() string {
+	return "copyMessage"
+}
+
+func (p CopyMessageParams) do(ctx context.Context, b *Bot) (*MessageID, error) {
+	var result MessageID
+	if err := b.makeParamsRequest(ctx, "copyMessage", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p CopyMessageParams) call(ctx context.Context, b *Bot) (*MessageID, error) {
+	return p.do(ctx, b)
+}
+
+// CopyMessagesParams represents the params of CopyMessages.
+type CopyMessagesParams struct {
+	ChatID              ChatID `json:"chat_id"`
+	MessageThreadID     int    `json:"message_thread_id,omitempty"`
+	FromChatID          ChatID `json:"from_chat_id"`
+	MessageIDs          []int  `json:"message_ids"`
+	DisableNotification bool   `json:"disable_notification,omitempty"`
+	ProtectContent      bool   `json:"protect_content,omitempty"`
+	RemoveCaption       bool   `json:"remove_caption,omitempty"`
+}
+
+// Method returns "copyMessages".
+func (CopyMessagesParams) Method() string {
+	return "copyMessages"
+}
+
+func (p CopyMessagesParams) do(ctx context.Context, b *Bot) ([]MessageID, error) {
+	var result []MessageID
+	err := b.makeParamsRequest(ctx, "copyMessages", p, nil, &result)
+	return result, err
+}
+
+func (p CopyMessagesParams) call(ctx context.Context, b *Bot) ([]MessageID, error) {
+	return p.do(ctx, b)
+}
+
+// CopyMessages copies messages of any kind.
+func (b *Bot) CopyMessages(ctx context.Context, params CopyMessagesParams) ([]MessageID, error) {
+	return params.do(ctx, b)
+}
+
+// CreateChatInviteLinkParams represents the params of CreateChatInviteLink.
+type CreateChatInviteLinkParams struct {
+	ChatID             ChatID `json:"chat_id"`
+	Name               string `json:"name,omitempty"`
+	ExpireDate         int    `json:"expire_date,omitempty"`
+	MemberLimit        int    `json:"member_limit,omitempty"`
+	CreatesJoinRequest bool   `json:"creates_join_request,omitempty"`
+}
+
+// Method returns "createChatInviteLink".
+func (CreateChatInviteLinkParams) Method() string {
+	return "createChatInviteLink"
+}
+
+func (p CreateChatInviteLinkParams) do(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	var result ChatInviteLink
+	if err := b.makeParamsRequest(ctx, "createChatInviteLink", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p CreateChatInviteLinkParams) call(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	return p.do(ctx, b)
+}
+
+// CreateChatSubscriptionInviteLinkParams represents the params of CreateChatSubscriptionInviteLink.
+type CreateChatSubscriptionInviteLinkParams struct {
+	ChatID             ChatID `json:"chat_id"`
+	Name               string `json:"name,omitempty"`
+	SubscriptionPeriod int    `json:"subscription_period"`
+	SubscriptionPrice  int    `json:"subscription_price"`
+}
+
+// Method returns "createChatSubscriptionInviteLink".
+func (CreateChatSubscriptionInviteLinkParams) Method() string {
+	return "createChatSubscriptionInviteLink"
+}
+
+func (p CreateChatSubscriptionInviteLinkParams) do(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	var result ChatInviteLink
+	if err := b.makeParamsRequest(ctx, "createChatSubscriptionInviteLink", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p CreateChatSubscriptionInviteLinkParams) call(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	return p.do(ctx, b)
+}
+
+// CreateChatSubscriptionInviteLink creates a subscription invite link for a channel chat.
+func (b *Bot) CreateChatSubscriptionInviteLink(ctx context.Context, params CreateChatSubscriptionInviteLinkParams) (*ChatInviteLink, error) {
+	return params.do(ctx, b)
+}
+
+// CreateForumTopicParams represents the params of CreateForumTopic.
+type CreateForumTopicParams struct {
+	ChatID            ChatID `json:"chat_id"`
+	Name              string `json:"name"`
+	IconColor         int    `json:"icon_color,omitempty"`
+	IconCustomEmojiID string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// Method returns "createForumTopic".
+func (CreateForumTopicParams) Method() string {
+	return "createForumTopic"
+}
+
+func (p CreateForumTopicParams) do(ctx context.Context, b *Bot) (*ForumTopic, error) {
+	var result ForumTopic
+	if err := b.makeParamsRequest(ctx, "createForumTopic", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p CreateForumTopicParams) call(ctx context.Context, b *Bot) (*ForumTopic, error) {
+	return p.do(ctx, b)
+}
+
+// CreateInvoiceLinkParams represents the params of CreateInvoiceLink.
+type CreateInvoiceLinkParams struct {
+	Title                     string         `json:"title"`
+	Description               string         `json:"description"`
+	Payload                   string         `json:"payload"`
+	ProviderToken             string         `json:"provider_token,omitempty"`
+	Currency                  string         `json:"currency"`
+	Prices                    []LabeledPrice `json:"prices"`
+	MaxTipAmount              int            `json:"max_tip_amount,omitempty"`
+	SuggestedTipAmounts       []int          `json:"suggested_tip_amounts,omitempty"`
+	ProviderData              string         `json:"provider_data,omitempty"`
+	PhotoURL                  string         `json:"photo_url,omitempty"`
+	PhotoSize                 int            `json:"photo_size,omitempty"`
+	PhotoWidth                int            `json:"photo_width,omitempty"`
+	PhotoHeight               int            `json:"photo_height,omitempty"`
+	NeedName                  bool           `json:"need_name,omitempty"`
+	NeedPhoneNumber           bool           `json:"need_phone_number,omitempty"`
+	NeedEmail                 bool           `json:"need_email,omitempty"`
+	NeedShippingAddress       bool           `json:"need_shipping_address,omitempty"`
+	SendPhoneNumberToProvider bool           `json:"send_phone_number_to_provider,omitempty"`
+	SendEmailToProvider       bool           `json:"send_email_to_provider,omitempty"`
+	IsFlexible                bool           `json:"is_flexible,omitempty"`
+}
+
+// Method returns "createInvoiceLink".
+func (CreateInvoiceLinkParams) Method() string {
+	return "createInvoiceLink"
+}
+
+func (p CreateInvoiceLinkParams) do(ctx context.Context, b *Bot) (string, error) {
+	var result string
+	err := b.makeParamsRequest(ctx, "createInvoiceLink", p, nil, &result)
+	return result, err
+}
+
+func (p CreateInvoiceLinkParams) call(ctx context.Context, b *Bot) (string, error) {
+	return p.do(ctx, b)
+}
+
+// CreateInvoiceLink creates a link for an invoice.
+func (b *Bot) CreateInvoiceLink(ctx context.Context, params CreateInvoiceLinkParams) (string, error) {
+	return params.do(ctx, b)
+}
+
+// CreateNewStickerSetParams represents the params of CreateNewStickerSet.
+type CreateNewStickerSetParams struct {
+	UserID          int64          `json:"user_id"`
+	Name            string         `json:"name"`
+	Title           string         `json:"title"`
+	Stickers        []InputSticker `json:"stickers"`
+	StickerType     string         `json:"sticker_type,omitempty"`
+	NeedsRepainting bool           `json:"needs_repainting,omitempty"`
+}
+
+// Method returns "createNewStickerSet".
+func (CreateNewStickerSetParams) Method() string {
+	return "createNewStickerSet"
+}
+
+func (p CreateNewStickerSetParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "createNewStickerSet", p, nil, nil)
+}
+
+func (p CreateNewStickerSetParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// CreateNewStickerSet creates a new sticker set owned by a user.
+func (b *Bot) CreateNewStickerSet(ctx context.Context, params CreateNewStickerSetParams) error {
+	return params.do(ctx, b)
+}
+
+// DeclineChatJoinRequestParams represents the params of DeclineChatJoinRequest.
+type DeclineChatJoinRequestParams struct {
+	ChatID ChatID `json:"chat_id"`
+	UserID int64  `json:"user_id"`
+}
+
+// Method returns "declineChatJoinRequest".
+func (DeclineChatJoinRequestParams) Method() string {
+	return "declineChatJoinRequest"
+}
+
+func (p DeclineChatJoinRequestParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "declineChatJoinRequest", p, nil, nil)
+}
+
+func (p DeclineChatJoinRequestParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteChatPhotoParams represents the params of DeleteChatPhoto.
+type DeleteChatPhotoParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "deleteChatPhoto".
+func (DeleteChatPhotoParams) Method() string {
+	return "deleteChatPhoto"
+}
+
+func (p DeleteChatPhotoParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteChatPhoto", p, nil, nil)
+}
+
+func (p DeleteChatPhotoParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteChatStickerSetParams represents the params of DeleteChatStickerSet.
+type DeleteChatStickerSetParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "deleteChatStickerSet".
+func (DeleteChatStickerSetParams) Method() string {
+	return "deleteChatStickerSet"
+}
+
+func (p DeleteChatStickerSetParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteChatStickerSet", p, nil, nil)
+}
+
+func (p DeleteChatStickerSetParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteChatStickerSet deletes a group sticker set from a supergroup.
+func (b *Bot) DeleteChatStickerSet(ctx context.Context, params DeleteChatStickerSetParams) error {
+	return params.do(ctx, b)
+}
+
+// DeleteForumTopicParams represents the params of DeleteForumTopic.
+type DeleteForumTopicParams struct {
+	ChatID          ChatID `json:"chat_id"`
+	MessageThreadID int    `json:"message_thread_id"`
+}
+
+// Method returns "deleteForumTopic".
+func (DeleteForumTopicParams) Method() string {
+	return "deleteForumTopic"
+}
+
+func (p DeleteForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteForumTopic", p, nil, nil)
+}
+
+func (p DeleteForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteMessageParams represents the params of DeleteMessage.
+type DeleteMessageParams struct {
+	ChatID    ChatID `json:"chat_id"`
+	MessageID int    `json:"message_id"`
+}
+
+// Method returns "deleteMessage".
+func (DeleteMessageParams) Method() string {
+	return "deleteMessage"
+}
+
+func (p DeleteMessageParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteMessage", p, nil, nil)
+}
+
+func (p DeleteMessageParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteMessagesParams represents the params of DeleteMessages.
+type DeleteMessagesParams struct {
+	ChatID     ChatID `json:"chat_id"`
+	MessageIDs []int  `json:"message_ids"`
+}
+
+// Method returns "deleteMessages".
+func (DeleteMessagesParams) Method() string {
+	return "deleteMessages"
+}
+
+func (p DeleteMessagesParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteMessages", p, nil, nil)
+}
+
+func (p DeleteMessagesParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteMessages deletes multiple messages simultaneously.
+func (b *Bot) DeleteMessages(ctx context.Context, params DeleteMessagesParams) error {
+	return params.do(ctx, b)
+}
+
+// DeleteMyCommandsParams represents the params of DeleteMyCommands.
+type DeleteMyCommandsParams struct {
+	Scope        *BotCommandScope `json:"scope,omitempty"`
+	LanguageCode string           `json:"language_code,omitempty"`
+}
+
+// Method returns "deleteMyCommands".
+func (DeleteMyCommandsParams) Method() string {
+	return "deleteMyCommands"
+}
+
+func (p DeleteMyCommandsParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteMyCommands", p, nil, nil)
+}
+
+func (p DeleteMyCommandsParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteMyCommands deletes the list of the bot's commands for the given scope and user language.
+func (b *Bot) DeleteMyCommands(ctx context.Context, params DeleteMyCommandsParams) error {
+	return params.do(ctx, b)
+}
+
+// DeleteStickerFromSetParams represents the params of DeleteStickerFromSet.
+type DeleteStickerFromSetParams struct {
+	Sticker string `json:"sticker"`
+}
+
+// Method returns "deleteStickerFromSet".
+func (DeleteStickerFromSetParams) Method() string {
+	return "deleteStickerFromSet"
+}
+
+func (p DeleteStickerFromSetParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteStickerFromSet", p, nil, nil)
+}
+
+func (p DeleteStickerFromSetParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteStickerFromSet deletes a sticker from a set created by the bot.
+func (b *Bot) DeleteStickerFromSet(ctx context.Context, params DeleteStickerFromSetParams) error {
+	return params.do(ctx, b)
+}
+
+// DeleteStickerSetParams represents the params of DeleteStickerSet.
+type DeleteStickerSetParams struct {
+	Name string `json:"name"`
+}
+
+// Method returns "deleteStickerSet".
+func (DeleteStickerSetParams) Method() string {
+	return "deleteStickerSet"
+}
+
+func (p DeleteStickerSetParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteStickerSet", p, nil, nil)
+}
+
+func (p DeleteStickerSetParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// DeleteStickerSet deletes a sticker set that was created by the bot.
+func (b *Bot) DeleteStickerSet(ctx context.Context, params DeleteStickerSetParams) error {
+	return params.do(ctx, b)
+}
+
+// DeleteWebhookParams represents the params of DeleteWebhook.
+type DeleteWebhookParams struct {
+	DropPendingUpdates bool `json:"drop_pending_updates,omitempty"`
+}
+
+// Method returns "deleteWebhook".
+func (DeleteWebhookParams) Method() string {
+	return "deleteWebhook"
+}
+
+func (p DeleteWebhookParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "deleteWebhook", p, nil, nil)
+}
+
+func (p DeleteWebhookParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// EditChatInviteLinkParams represents the params of EditChatInviteLink.
+type EditChatInviteLinkParams struct {
+	ChatID             ChatID `json:"chat_id"`
+	InviteLink         string `json:"invite_link"`
+	Name               string `json:"name,omitempty"`
+	ExpireDate         int    `json:"expire_date,omitempty"`
+	MemberLimit        int    `json:"member_limit,omitempty"`
+	CreatesJoinRequest *bool  `json:"creates_join_request,omitempty"`
+}
+
+// Method returns "editChatInviteLink".
+func (EditChatInviteLinkParams) Method() string {
+	return "editChatInviteLink"
+}
+
+func (p EditChatInviteLinkParams) do(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	var result ChatInviteLink
+	if err := b.makeParamsRequest(ctx, "editChatInviteLink", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p EditChatInviteLinkParams) call(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	return p.do(ctx, b)
+}
+
+// EditChatSubscriptionInviteLinkParams represents the params of EditChatSubscriptionInviteLink.
+type EditChatSubscriptionInviteLinkParams struct {
+	ChatID     ChatID `json:"chat_id"`
+	InviteLink string `json:"invite_link"`
+	Name       string `json:"name,omitempty"`
+}
+
+// Method returns "editChatSubscriptionInviteLink".
+func (EditChatSubscriptionInviteLinkParams) Method() string {
+	return "editChatSubscriptionInviteLink"
+}
+
+func (p EditChatSubscriptionInviteLinkParams) do(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	var result ChatInviteLink
+	if err := b.makeParamsRequest(ctx, "editChatSubscriptionInviteLink", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p EditChatSubscriptionInviteLinkParams) call(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	return p.do(ctx, b)
+}
+
+// EditChatSubscriptionInviteLink edits a subscription invite link created by the bot.
+func (b *Bot) EditChatSubscriptionInviteLink(ctx context.Context, params EditChatSubscriptionInviteLinkParams) (*ChatInviteLink, error) {
+	return params.do(ctx, b)
+}
+
+// EditForumTopicParams represents the params of EditForumTopic.
+type EditForumTopicParams struct {
+	ChatID            ChatID  `json:"chat_id"`
+	MessageThreadID   int     `json:"message_thread_id"`
+	Name              string  `json:"name,omitempty"`
+	IconCustomEmojiID *string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+// Method returns "editForumTopic".
+func (EditForumTopicParams) Method() string {
+	return "editForumTopic"
+}
+
+func (p EditForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "editForumTopic", p, nil, nil)
+}
+
+func (p EditForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// EditGeneralForumTopicParams represents the params of EditGeneralForumTopic.
+type EditGeneralForumTopicParams struct {
+	ChatID ChatID `json:"chat_id"`
+	Name   string `json:"name"`
+}
+
+// Method returns "editGeneralForumTopic".
+func (EditGeneralForumTopicParams) Method() string {
+	return "editGeneralForumTopic"
+}
+
+func (p EditGeneralForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "editGeneralForumTopic", p, nil, nil)
+}
+
+func (p EditGeneralForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// EditMessageCaptionParams represents the params of EditMessageCaption.
+type EditMessageCaptionParams struct {
+	BusinessConnectionID  string                `json:"business_connection_id,omitempty"`
+	ChatID                ChatID                `json:"chat_id,omitempty"`
+	MessageID             int                   `json:"message_id,omitempty"`
+	InlineMessageID       string                `json:"inline_message_id,omitempty"`
+	Caption               string                `json:"caption,omitempty"`
+	ParseMode             string                `json:"parse_mode,omitempty"`
+	CaptionEntities       []MessageEntity       `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia *bool                 `json:"show_caption_above_media,omitempty"`
+	ReplyMarkup           *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "editMessageCaption".
+func (EditMessageCaptionParams) Method() string {
+	return "editMessageCaption"
+}
+
+func (p EditMessageCaptionParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result messageOrTrue
+	if err := b.makeParamsRequest(ctx, "editMessageCaption", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Message, nil
+}
+
+func (p EditMessageCaptionParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// EditMessageCaption edits captions of messages.
+func (b *Bot) EditMessageCaption(ctx context.Context, params EditMessageCaptionParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// EditMessageLiveLocationParams represents the params of EditMessageLiveLocation.
+type EditMessageLiveLocationParams struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               ChatID                `json:"chat_id,omitempty"`
+	MessageID            int                   `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	Latitude             float64               `json:"latitude"`
+	Longitude            float64               `json:"longitude"`
+	LivePeriod           int                   `json:"live_period,omitempty"`
+	HorizontalAccuracy   float64               `json:"horizontal_accuracy,omitempty"`
+	Heading              int                   `json:"heading,omitempty"`
+	ProximityAlertRadius int                   `json:"proximity_alert_radius,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "editMessageLiveLocation".
+func (EditMessageLiveLocationParams) Method() string {
+	return "editMessageLiveLocation"
+}
+
+func (p EditMessageLiveLocationParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result messageOrTrue
+	if err := b.makeParamsRequest(ctx, "editMessageLiveLocation", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Message, nil
+}
+
+func (p EditMessageLiveLocationParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// EditMessageLiveLocation edits live location messages.
+func (b *Bot) EditMessageLiveLocation(ctx context.Context, params EditMessageLiveLocationParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// EditMessageMediaParams represents the params of EditMessageMedia.
+type EditMessageMediaParams struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               ChatID                `json:"chat_id,omitempty"`
+	MessageID            int                   `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	Media                *InputMedia           `json:"media"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "editMessageMedia".
+func (EditMessageMediaParams) Method() string {
+	return "editMessageMedia"
+}
+
+func (p EditMessageMediaParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result messageOrTrue
+	if err := b.makeParamsRequest(ctx, "editMessageMedia", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Message, nil
+}
+
+func (p EditMessageMediaParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// EditMessageMedia edits animation, audio, document, photo, or video messages, or to add media to text messages.
+func (b *Bot) EditMessageMedia(ctx context.Context, params EditMessageMediaParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// EditMessageReplyMarkupParams represents the params of EditMessageReplyMarkup.
+type EditMessageReplyMarkupParams struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               ChatID                `json:"chat_id,omitempty"`
+	MessageID            int                   `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "editMessageReplyMarkup".
+func (EditMessageReplyMarkupParams) Method() string {
+	return "editMessageReplyMarkup"
+}
+
+func (p EditMessageReplyMarkupParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result messageOrTrue
+	if err := b.makeParamsRequest(ctx, "editMessageReplyMarkup", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Message, nil
+}
+
+func (p EditMessageReplyMarkupParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// EditMessageReplyMarkup edits only the reply markup of messages.
+func (b *Bot) EditMessageReplyMarkup(ctx context.Context, params EditMessageReplyMarkupParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// EditMessageTextParams represents the params of EditMessageText.
+type EditMessageTextParams struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               ChatID                `json:"chat_id,omitempty"`
+	MessageID            int                   `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	Text                 string                `json:"text"`
+	ParseMode            string                `json:"parse_mode,omitempty"`
+	Entities             []MessageEntity       `json:"entities,omitempty"`
+	LinkPreviewOptions   *LinkPreviewOptions   `json:"link_preview_options,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "editMessageText".
+func (EditMessageTextParams) Method() string {
+	return "editMessageText"
+}
+
+func (p EditMessageTextParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result messageOrTrue
+	if err := b.makeParamsRequest(ctx, "editMessageText", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Message, nil
+}
+
+func (p EditMessageTextParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// ExportChatInviteLinkParams represents the params of ExportChatInviteLink.
+type ExportChatInviteLinkParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "exportChatInviteLink".
+func (ExportChatInviteLinkParams) Method() string {
+	return "exportChatInviteLink"
+}
+
+func (p ExportChatInviteLinkParams) do(ctx context.Context, b *Bot) (string, error) {
+	var result string
+	err := b.makeParamsRequest(ctx, "exportChatInviteLink", p, nil, &result)
+	return result, err
+}
+
+func (p ExportChatInviteLinkParams) call(ctx context.Context, b *Bot) (string, error) {
+	return p.do(ctx, b)
+}
+
+// ForwardMessageParams represents the params of ForwardMessage.
+type ForwardMessageParams struct {
+	ChatID              ChatID `json:"chat_id"`
+	MessageThreadID     int    `json:"message_thread_id,omitempty"`
+	FromChatID          ChatID `json:"from_chat_id"`
+	DisableNotification bool   `json:"disable_notification,omitempty"`
+	ProtectContent      bool   `json:"protect_content,omitempty"`
+	MessageID           int    `json:"message_id"`
+}
+
+// Method returns "forwardMessage".
+func (ForwardMessageParams) Method() string {
+	return "forwardMessage"
+}
+
+func (p ForwardMessageParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "forwardMessage", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p ForwardMessageParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// ForwardMessagesParams represents the params of ForwardMessages.
+type ForwardMessagesParams struct {
+	ChatID              ChatID `json:"chat_id"`
+	MessageThreadID     int    `json:"message_thread_id,omitempty"`
+	FromChatID          ChatID `json:"from_chat_id"`
+	MessageIDs          []int  `json:"message_ids"`
+	DisableNotification bool   `json:"disable_notification,omitempty"`
+	ProtectContent      bool   `json:"protect_content,omitempty"`
+}
+
+// Method returns "forwardMessages".
+func (ForwardMessagesParams) Method() string {
+	return "forwardMessages"
+}
+
+func (p ForwardMessagesParams) do(ctx context.Context, b *Bot) ([]MessageID, error) {
+	var result []MessageID
+	err := b.makeParamsRequest(ctx, "forwardMessages", p, nil, &result)
+	return result, err
+}
+
+func (p ForwardMessagesParams) call(ctx context.Context, b *Bot) ([]MessageID, error) {
+	return p.do(ctx, b)
+}
+
+// ForwardMessages forwards multiple messages of any kind.
+func (b *Bot) ForwardMessages(ctx context.Context, params ForwardMessagesParams) ([]MessageID, error) {
+	return params.do(ctx, b)
+}
+
+// GetBusinessConnectionParams represents the params of GetBusinessConnection.
+type GetBusinessConnectionParams struct {
+	BusinessConnectionID string `json:"business_connection_id"`
+}
+
+// Method returns "getBusinessConnection".
+func (GetBusinessConnectionParams) Method() string {
+	return "getBusinessConnection"
+}
+
+func (p GetBusinessConnectionParams) do(ctx context.Context, b *Bot) (*BusinessConnection, error) {
+	var result BusinessConnection
+	if err := b.makeParamsRequest(ctx, "getBusinessConnection", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetBusinessConnectionParams) call(ctx context.Context, b *Bot) (*BusinessConnection, error) {
+	return p.do(ctx, b)
+}
+
+// GetBusinessConnection gets information about the connection of the bot with a business account.
+func (b *Bot) GetBusinessConnection(ctx context.Context, params GetBusinessConnectionParams) (*BusinessConnection, error) {
+	return params.do(ctx, b)
+}
+
+// GetChatParams represents the params of GetChat.
+type GetChatParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "getChat".
+func (GetChatParams) Method() string {
+	return "getChat"
+}
+
+func (p GetChatParams) do(ctx context.Context, b *Bot) (*ChatFullInfo, error) {
+	var result ChatFullInfo
+	if err := b.makeParamsRequest(ctx, "getChat", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetChatParams) call(ctx context.Context, b *Bot) (*ChatFullInfo, error) {
+	return p.do(ctx, b)
+}
+
+// GetChatAdministratorsParams represents the params of GetChatAdministrators.
+type GetChatAdministratorsParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "getChatAdministrators".
+func (GetChatAdministratorsParams) Method() string {
+	return "getChatAdministrators"
+}
+
+func (p GetChatAdministratorsParams) do(ctx context.Context, b *Bot) ([]ChatMember, error) {
+	var result []ChatMember
+	err := b.makeParamsRequest(ctx, "getChatAdministrators", p, nil, &result)
+	return result, err
+}
+
+func (p GetChatAdministratorsParams) call(ctx context.Context, b *Bot) ([]ChatMember, error) {
+	return p.do(ctx, b)
+}
+
+// GetChatMemberParams represents the params of GetChatMember.
+type GetChatMemberParams struct {
+	ChatID ChatID `json:"chat_id"`
+	UserID int64  `json:"user_id"`
+}
+
+// Method returns "getChatMember".
+func (GetChatMemberParams) Method() string {
+	return "getChatMember"
+}
+
+func (p GetChatMemberParams) do(ctx context.Context, b *Bot) (*ChatMember, error) {
+	var result ChatMember
+	if err := b.makeParamsRequest(ctx, "getChatMember", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetChatMemberParams) call(ctx context.Context, b *Bot) (*ChatMember, error) {
+	return p.do(ctx, b)
+}
+
+// GetChatMemberCountParams represents the params of GetChatMemberCount.
+type GetChatMemberCountParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "getChatMemberCount".
+func (GetChatMemberCountParams) Method() string {
+	return "getChatMemberCount"
+}
+
+func (p GetChatMemberCountParams) do(ctx context.Context, b *Bot) (int, error) {
+	var result int
+	err := b.makeParamsRequest(ctx, "getChatMemberCount", p, nil, &result)
+	return result, err
+}
+
+func (p GetChatMemberCountParams) call(ctx context.Context, b *Bot) (int, error) {
+	return p.do(ctx, b)
+}
+
+// GetChatMenuButtonParams represents the params of GetChatMenuButton.
+type GetChatMenuButtonParams struct {
+	ChatID int64 `json:"chat_id,omitempty"`
+}
+
+// Method returns "getChatMenuButton".
+func (GetChatMenuButtonParams) Method() string {
+	return "getChatMenuButton"
+}
+
+func (p GetChatMenuButtonParams) do(ctx context.Context, b *Bot) (*MenuButton, error) {
+	var result MenuButton
+	if err := b.makeParamsRequest(ctx, "getChatMenuButton", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetChatMenuButtonParams) call(ctx context.Context, b *Bot) (*MenuButton, error) {
+	return p.do(ctx, b)
+}
+
+// GetChatMenuButton gets the current value of the bot's menu button in a private chat, or the default menu button.
+func (b *Bot) GetChatMenuButton(ctx context.Context, params GetChatMenuButtonParams) (*MenuButton, error) {
+	return params.do(ctx, b)
+}
+
+// GetCustomEmojiStickersParams represents the params of GetCustomEmojiStickers.
+type GetCustomEmojiStickersParams struct {
+	CustomEmojiIDs []string `json:"custom_emoji_ids"`
+}
+
+// Method returns "getCustomEmojiStickers".
+func (GetCustomEmojiStickersParams) Method() string {
+	return "getCustomEmojiStickers"
+}
+
+func (p GetCustomEmojiStickersParams) do(ctx context.Context, b *Bot) ([]Sticker, error) {
+	var result []Sticker
+	err := b.makeParamsRequest(ctx, "getCustomEmojiStickers", p, nil, &result)
+	return result, err
+}
+
+func (p GetCustomEmojiStickersParams) call(ctx context.Context, b *Bot) ([]Sticker, error) {
+	return p.do(ctx, b)
+}
+
+// GetCustomEmojiStickers gets information about custom emoji stickers by their identifiers.
+func (b *Bot) GetCustomEmojiStickers(ctx context.Context, params GetCustomEmojiStickersParams) ([]Sticker, error) {
+	return params.do(ctx, b)
+}
+
+// GetFileParams represents the params of GetFile.
+type GetFileParams struct {
+	FileID string `json:"file_id"`
+}
+
+// Method returns "getFile".
+func (GetFileParams) Method() string {
+	return "getFile"
+}
+
+func (p GetFileParams) do(ctx context.Context, b *Bot) (*File, error) {
+	var result File
+	if err := b.makeParamsRequest(ctx, "getFile", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetFileParams) call(ctx context.Context, b *Bot) (*File, error) {
+	return p.do(ctx, b)
+}
+
+// GetForumTopicIconStickersParams represents the params of GetForumTopicIconStickers.
+type GetForumTopicIconStickersParams struct{}
+
+// Method returns "getForumTopicIconStickers".
+func (GetForumTopicIconStickersParams) Method() string {
+	return "getForumTopicIconStickers"
+}
+
+func (GetForumTopicIconStickersParams) do(ctx context.Context, b *Bot) ([]Sticker, error) {
+	var result []Sticker
+	err := b.makeParamsRequest(ctx, "getForumTopicIconStickers", nil, nil, &result)
+	return result, err
+}
+
+func (p GetForumTopicIconStickersParams) call(ctx context.Context, b *Bot) ([]Sticker, error) {
+	return p.do(ctx, b)
+}
+
+// GetGameHighScoresParams represents the params of GetGameHighScores.
+type GetGameHighScoresParams struct {
+	UserID          int64  `json:"user_id"`
+	ChatID          int64  `json:"chat_id,omitempty"`
+	MessageID       int    `json:"message_id,omitempty"`
+	InlineMessageID string `json:"inline_message_id,omitempty"`
+}
+
+// Method returns "getGameHighScores".
+func (GetGameHighScoresParams) Method() string {
+	return "getGameHighScores"
+}
+
+func (p GetGameHighScoresParams) do(ctx context.Context, b *Bot) ([]GameHighScore, error) {
+	var result []GameHighScore
+	err := b.makeParamsRequest(ctx, "getGameHighScores", p, nil, &result)
+	return result, err
+}
+
+func (p GetGameHighScoresParams) call(ctx context.Context, b *Bot) ([]GameHighScore, error) {
+	return p.do(ctx, b)
+}
+
+// GetGameHighScores gets data for high score tables.
+func (b *Bot) GetGameHighScores(ctx context.Context, params GetGameHighScoresParams) ([]GameHighScore, error) {
+	return params.do(ctx, b)
+}
+
+// GetMeParams represents the params of GetMe.
+type GetMeParams struct{}
+
+// Method returns "getMe".
+func (GetMeParams) Method() string {
+	return "getMe"
+}
+
+func (GetMeParams) do(ctx context.Context, b *Bot) (*User, error) {
+	var result User
+	if err := b.makeParamsRequest(ctx, "getMe", nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetMeParams) call(ctx context.Context, b *Bot) (*User, error) {
+	return p.do(ctx, b)
+}
+
+// GetMe is a simple method for testing your bot's authentication token.
+func (b *Bot) GetMe(ctx context.Context) (*User, error) {
+	return GetMeParams{}.do(ctx, b)
+}
+
+// GetMyCommandsParams represents the params of GetMyCommands.
+type GetMyCommandsParams struct {
+	Scope        *BotCommandScope `json:"scope,omitempty"`
+	LanguageCode string           `json:"language_code,omitempty"`
+}
+
+// Method returns "getMyCommands".
+func (GetMyCommandsParams) Method() string {
+	return "getMyCommands"
+}
+
+func (p GetMyCommandsParams) do(ctx context.Context, b *Bot) ([]BotCommand, error) {
+	var result []BotCommand
+	err := b.makeParamsRequest(ctx, "getMyCommands", p, nil, &result)
+	return result, err
+}
+
+func (p GetMyCommandsParams) call(ctx context.Context, b *Bot) ([]BotCommand, error) {
+	return p.do(ctx, b)
+}
+
+// GetMyCommands gets the current list of the bot's commands for the given scope and user language.
+func (b *Bot) GetMyCommands(ctx context.Context, params GetMyCommandsParams) ([]BotCommand, error) {
+	return params.do(ctx, b)
+}
+
+// GetMyDefaultAdministratorRightsParams represents the params of GetMyDefaultAdministratorRights.
+type GetMyDefaultAdministratorRightsParams struct {
+	ForChannels bool `json:"for_channels,omitempty"`
+}
+
+// Method returns "getMyDefaultAdministratorRights".
+func (GetMyDefaultAdministratorRightsParams) Method() string {
+	return "getMyDefaultAdministratorRights"
+}
+
+func (p GetMyDefaultAdministratorRightsParams) do(ctx context.Context, b *Bot) (*ChatAdministratorRights, error) {
+	var result ChatAdministratorRights
+	if err := b.makeParamsRequest(ctx, "getMyDefaultAdministratorRights", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetMyDefaultAdministratorRightsParams) call(ctx context.Context, b *Bot) (*ChatAdministratorRights, error) {
+	return p.do(ctx, b)
+}
+
+// GetMyDefaultAdministratorRights gets the current default administrator rights of the bot.
+func (b *Bot) GetMyDefaultAdministratorRights(ctx context.Context, params GetMyDefaultAdministratorRightsParams) (*ChatAdministratorRights, error) {
+	return params.do(ctx, b)
+}
+
+// GetMyDescriptionParams represents the params of GetMyDescription.
+type GetMyDescriptionParams struct {
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Method returns "getMyDescription".
+func (GetMyDescriptionParams) Method() string {
+	return "getMyDescription"
+}
+
+func (p GetMyDescriptionParams) do(ctx context.Context, b *Bot) (*BotDescription, error) {
+	var result BotDescription
+	if err := b.makeParamsRequest(ctx, "getMyDescription", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetMyDescriptionParams) call(ctx context.Context, b *Bot) (*BotDescription, error) {
+	return p.do(ctx, b)
+}
+
+// GetMyDescription gets the current bot description for the given user language.
+func (b *Bot) GetMyDescription(ctx context.Context, params GetMyDescriptionParams) (*BotDescription, error) {
+	return params.do(ctx, b)
+}
+
+// GetMyNameParams represents the params of GetMyName.
+type GetMyNameParams struct {
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Method returns "getMyName".
+func (GetMyNameParams) Method() string {
+	return "getMyName"
+}
+
+func (p GetMyNameParams) do(ctx context.Context, b *Bot) (*BotName, error) {
+	var result BotName
+	if err := b.makeParamsRequest(ctx, "getMyName", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetMyNameParams) call(ctx context.Context, b *Bot) (*BotName, error) {
+	return p.do(ctx, b)
+}
+
+// GetMyName gets the current bot name for the given user language.
+func (b *Bot) GetMyName(ctx context.Context, params GetMyNameParams) (*BotName, error) {
+	return params.do(ctx, b)
+}
+
+// GetMyShortDescriptionParams represents the params of GetMyShortDescription.
+type GetMyShortDescriptionParams struct {
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+// Method returns "getMyShortDescription".
+func (GetMyShortDescriptionParams) Method() string {
+	return "getMyShortDescription"
+}
+
+func (p GetMyShortDescriptionParams) do(ctx context.Context, b *Bot) (*BotShortDescription, error) {
+	var result BotShortDescription
+	if err := b.makeParamsRequest(ctx, "getMyShortDescription", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetMyShortDescriptionParams) call(ctx context.Context, b *Bot) (*BotShortDescription, error) {
+	return p.do(ctx, b)
+}
+
+// GetMyShortDescription gets the current bot short description for the given user language.
+func (b *Bot) GetMyShortDescription(ctx context.Context, params GetMyShortDescriptionParams) (*BotShortDescription, error) {
+	return params.do(ctx, b)
+}
+
+// GetStarTransactionsParams represents the params of GetStarTransactions.
+type GetStarTransactionsParams struct {
+	Offset int  `json:"offset,omitempty"`
+	Limit  *int `json:"limit,omitempty"`
+}
+
+// Method returns "getStarTransactions".
+func (GetStarTransactionsParams) Method() string {
+	return "getStarTransactions"
+}
+
+func (p GetStarTransactionsParams) do(ctx context.Context, b *Bot) (*StarTransactions, error) {
+	var result StarTransactions
+	if err := b.makeParamsRequest(ctx, "getStarTransactions", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetStarTransactionsParams) call(ctx context.Context, b *Bot) (*StarTransactions, error) {
+	return p.do(ctx, b)
+}
+
+// GetStarTransactions returns the bot's Telegram Star transactions in chronological order.
+func (b *Bot) GetStarTransactions(ctx context.Context, params GetStarTransactionsParams) (*StarTransactions, error) {
+	return params.do(ctx, b)
+}
+
+// GetStickerSetParams represents the params of GetStickerSet.
+type GetStickerSetParams struct {
+	Name string `json:"name"`
+}
+
+// Method returns "getStickerSet".
+func (GetStickerSetParams) Method() string {
+	return "getStickerSet"
+}
+
+func (p GetStickerSetParams) do(ctx context.Context, b *Bot) (*StickerSet, error) {
+	var result StickerSet
+	if err := b.makeParamsRequest(ctx, "getStickerSet", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetStickerSetParams) call(ctx context.Context, b *Bot) (*StickerSet, error) {
+	return p.do(ctx, b)
+}
+
+// GetStickerSet gets a sticker set.
+func (b *Bot) GetStickerSet(ctx context.Context, params GetStickerSetParams) (*StickerSet, error) {
+	return params.do(ctx, b)
+}
+
+// GetUpdatesParams represents the params of GetUpdates.
+type GetUpdatesParams struct {
+	Offset         int      `json:"offset,omitempty"`
+	Limit          *int     `json:"limit,omitempty"`
+	Timeout        int      `json:"timeout,omitempty"`
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+// Method returns "getUpdates".
+func (GetUpdatesParams) Method() string {
+	return "getUpdates"
+}
+
+func (p GetUpdatesParams) do(ctx context.Context, b *Bot) ([]Update, error) {
+	var result []Update
+	err := b.makeParamsRequest(ctx, "getUpdates", p, nil, &result)
+	return result, err
+}
+
+func (p GetUpdatesParams) call(ctx context.Context, b *Bot) ([]Update, error) {
+	return p.do(ctx, b)
+}
+
+// GetUpdates receives incoming updates using long polling.
+func (b *Bot) GetUpdates(ctx context.Context, params GetUpdatesParams) ([]Update, error) {
+	return params.do(ctx, b)
+}
+
+// GetUserChatBoostsParams represents the params of GetUserChatBoosts.
+type GetUserChatBoostsParams struct {
+	ChatID ChatID `json:"chat_id"`
+	UserID int64  `json:"user_id"`
+}
+
+// Method returns "getUserChatBoosts".
+func (GetUserChatBoostsParams) Method() string {
+	return "getUserChatBoosts"
+}
+
+func (p GetUserChatBoostsParams) do(ctx context.Context, b *Bot) (*UserChatBoosts, error) {
+	var result UserChatBoosts
+	if err := b.makeParamsRequest(ctx, "getUserChatBoosts", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetUserChatBoostsParams) call(ctx context.Context, b *Bot) (*UserChatBoosts, error) {
+	return p.do(ctx, b)
+}
+
+// GetUserChatBoosts gets the list of boosts added to a chat by a user.
+func (b *Bot) GetUserChatBoosts(ctx context.Context, params GetUserChatBoostsParams) (*UserChatBoosts, error) {
+	return params.do(ctx, b)
+}
+
+// GetUserProfilePhotosParams represents the params of GetUserProfilePhotos.
+type GetUserProfilePhotosParams struct {
+	UserID int64 `json:"user_id"`
+	Offset int   `json:"offset,omitempty"`
+	Limit  *int  `json:"limit,omitempty"`
+}
+
+// Method returns "getUserProfilePhotos".
+func (GetUserProfilePhotosParams) Method() string {
+	return "getUserProfilePhotos"
+}
+
+func (p GetUserProfilePhotosParams) do(ctx context.Context, b *Bot) (*UserProfilePhotos, error) {
+	var result UserProfilePhotos
+	if err := b.makeParamsRequest(ctx, "getUserProfilePhotos", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetUserProfilePhotosParams) call(ctx context.Context, b *Bot) (*UserProfilePhotos, error) {
+	return p.do(ctx, b)
+}
+
+// GetUserProfilePhotos gets a list of profile pictures for a user.
+func (b *Bot) GetUserProfilePhotos(ctx context.Context, params GetUserProfilePhotosParams) (*UserProfilePhotos, error) {
+	return params.do(ctx, b)
+}
+
+// GetWebhookInfoParams represents the params of GetWebhookInfo.
+type GetWebhookInfoParams struct{}
+
+// Method returns "getWebhookInfo".
+func (GetWebhookInfoParams) Method() string {
+	return "getWebhookInfo"
+}
+
+func (GetWebhookInfoParams) do(ctx context.Context, b *Bot) (*WebhookInfo, error) {
+	var result WebhookInfo
+	if err := b.makeParamsRequest(ctx, "getWebhookInfo", nil, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p GetWebhookInfoParams) call(ctx context.Context, b *Bot) (*WebhookInfo, error) {
+	return p.do(ctx, b)
+}
+
+// HideGeneralForumTopicParams represents the params of HideGeneralForumTopic.
+type HideGeneralForumTopicParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "hideGeneralForumTopic".
+func (HideGeneralForumTopicParams) Method() string {
+	return "hideGeneralForumTopic"
+}
+
+func (p HideGeneralForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "hideGeneralForumTopic", p, nil, nil)
+}
+
+func (p HideGeneralForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// LeaveChatParams represents the params of LeaveChat.
+type LeaveChatParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "leaveChat".
+func (LeaveChatParams) Method() string {
+	return "leaveChat"
+}
+
+func (p LeaveChatParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "leaveChat", p, nil, nil)
+}
+
+func (p LeaveChatParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// LogOutParams represents the params of LogOut.
+type LogOutParams struct{}
+
+// Method returns "logOut".
+func (LogOutParams) Method() string {
+	return "logOut"
+}
+
+func (LogOutParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "logOut", nil, nil, nil)
+}
+
+func (p LogOutParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// LogOut logs out from the cloud Bot API server before launching the bot locally.
+func (b *Bot) LogOut(ctx context.Context) error {
+	return LogOutParams{}.do(ctx, b)
+}
+
+// PinChatMessageParams represents the params of PinChatMessage.
+type PinChatMessageParams struct {
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	ChatID               ChatID `json:"chat_id"`
+	MessageID            int    `json:"message_id"`
+	DisableNotification  bool   `json:"disable_notification,omitempty"`
+}
+
+// Method returns "pinChatMessage".
+func (PinChatMessageParams) Method() string {
+	return "pinChatMessage"
+}
+
+func (p PinChatMessageParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "pinChatMessage", p, nil, nil)
+}
+
+func (p PinChatMessageParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// PromoteChatMemberParams represents the params of PromoteChatMember.
+type PromoteChatMemberParams struct {
+	ChatID              ChatID `json:"chat_id"`
+	UserID              int64  `json:"user_id"`
+	IsAnonymous         bool   `json:"is_anonymous,omitempty"`
+	CanManageChat       bool   `json:"can_manage_chat,omitempty"`
+	CanDeleteMessages   bool   `json:"can_delete_messages,omitempty"`
+	CanManageVideoChats bool   `json:"can_manage_video_chats,omitempty"`
+	CanRestrictMembers  bool   `json:"can_restrict_members,omitempty"`
+	CanPromoteMembers   bool   `json:"can_promote_members,omitempty"`
+	CanChangeInfo       bool   `json:"can_change_info,omitempty"`
+	CanInviteUsers      bool   `json:"can_invite_users,omitempty"`
+	CanPostStories      bool   `json:"can_post_stories,omitempty"`
+	CanEditStories      bool   `json:"can_edit_stories,omitempty"`
+	CanDeleteStories    bool   `json:"can_delete_stories,omitempty"`
+	CanPostMessages     bool   `json:"can_post_messages,omitempty"`
+	CanEditMessages     bool   `json:"can_edit_messages,omitempty"`
+	CanPinMessages      bool   `json:"can_pin_messages,omitempty"`
+	CanManageTopics     bool   `json:"can_manage_topics,omitempty"`
+}
+
+// Method returns "promoteChatMember".
+func (PromoteChatMemberParams) Method() string {
+	return "promoteChatMember"
+}
+
+func (p PromoteChatMemberParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "promoteChatMember", p, nil, nil)
+}
+
+func (p PromoteChatMemberParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// RefundStarPaymentParams represents the params of RefundStarPayment.
+type RefundStarPaymentParams struct {
+	UserID                  int64  `json:"user_id"`
+	TelegramPaymentChargeID string `json:"telegram_payment_charge_id"`
+}
+
+// Method returns "refundStarPayment".
+func (RefundStarPaymentParams) Method() string {
+	return "refundStarPayment"
+}
+
+func (p RefundStarPaymentParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "refundStarPayment", p, nil, nil)
+}
+
+func (p RefundStarPaymentParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// RefundStarPayment refunds a successful payment in Telegram Stars.
+func (b *Bot) RefundStarPayment(ctx context.Context, params RefundStarPaymentParams) error {
+	return params.do(ctx, b)
+}
+
+// ReopenForumTopicParams represents the params of ReopenForumTopic.
+type ReopenForumTopicParams struct {
+	ChatID          ChatID `json:"chat_id"`
+	MessageThreadID int    `json:"message_thread_id"`
+}
+
+// Method returns "reopenForumTopic".
+func (ReopenForumTopicParams) Method() string {
+	return "reopenForumTopic"
+}
+
+func (p ReopenForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "reopenForumTopic", p, nil, nil)
+}
+
+func (p ReopenForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// ReopenGeneralForumTopicParams represents the params of ReopenGeneralForumTopic.
+type ReopenGeneralForumTopicParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "reopenGeneralForumTopic".
+func (ReopenGeneralForumTopicParams) Method() string {
+	return "reopenGeneralForumTopic"
+}
+
+func (p ReopenGeneralForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "reopenGeneralForumTopic", p, nil, nil)
+}
+
+func (p ReopenGeneralForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// ReplaceStickerInSetParams represents the params of ReplaceStickerInSet.
+type ReplaceStickerInSetParams struct {
+	UserID     int64         `json:"user_id"`
+	Name       string        `json:"name"`
+	OldSticker string        `json:"old_sticker"`
+	Sticker    *InputSticker `json:"sticker"`
+}
+
+// Method returns "replaceStickerInSet".
+func (ReplaceStickerInSetParams) Method() string {
+	return "replaceStickerInSet"
+}
+
+func (p ReplaceStickerInSetParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "replaceStickerInSet", p, nil, nil)
+}
+
+func (p ReplaceStickerInSetParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// ReplaceStickerInSet replaces an existing sticker in a sticker set with a new one.
+func (b *Bot) ReplaceStickerInSet(ctx context.Context, params ReplaceStickerInSetParams) error {
+	return params.do(ctx, b)
+}
+
+// RestrictChatMemberParams represents the params of RestrictChatMember.
+type RestrictChatMemberParams struct {
+	ChatID                        ChatID           `json:"chat_id"`
+	UserID                        int64            `json:"user_id"`
+	Permissions                   *ChatPermissions `json:"permissions"`
+	UseIndependentChatPermissions bool             `json:"use_independent_chat_permissions,omitempty"`
+	UntilDate                     int              `json:"until_date,omitempty"`
+}
+
+// Method returns "restrictChatMember".
+func (RestrictChatMemberParams) Method() string {
+	return "restrictChatMember"
+}
+
+func (p RestrictChatMemberParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "restrictChatMember", p, nil, nil)
+}
+
+func (p RestrictChatMemberParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// RevokeChatInviteLinkParams represents the params of RevokeChatInviteLink.
+type RevokeChatInviteLinkParams struct {
+	ChatID     ChatID `json:"chat_id"`
+	InviteLink string `json:"invite_link"`
+}
+
+// Method returns "revokeChatInviteLink".
+func (RevokeChatInviteLinkParams) Method() string {
+	return "revokeChatInviteLink"
+}
+
+func (p RevokeChatInviteLinkParams) do(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	var result ChatInviteLink
+	if err := b.makeParamsRequest(ctx, "revokeChatInviteLink", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p RevokeChatInviteLinkParams) call(ctx context.Context, b *Bot) (*ChatInviteLink, error) {
+	return p.do(ctx, b)
+}
+
+// SendAnimationParams represents the params of SendAnimation.
+type SendAnimationParams struct {
+	BusinessConnectionID  string           `json:"business_connection_id,omitempty"`
+	ChatID                ChatID           `json:"chat_id"`
+	MessageThreadID       int              `json:"message_thread_id,omitempty"`
+	Animation             interface{}      `json:"animation"`
+	Duration              int              `json:"duration,omitempty"`
+	Width                 int              `json:"width,omitempty"`
+	Height                int              `json:"height,omitempty"`
+	Thumbnail             interface{}      `json:"thumbnail,omitempty"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             string           `json:"parse_mode,omitempty"`
+	CaptionEntities       []MessageEntity  `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`
+	DisableNotification   bool             `json:"disable_notification,omitempty"`
+	ProtectContent        bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast    bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID       string           `json:"message_effect_id,omitempty"`
+	ReplyParameters       *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup           ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendAnimation".
+func (SendAnimationParams) Method() string {
+	return "sendAnimation"
+}
+
+func (p SendAnimationParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendAnimation", p, []string{"animation", "thumbnail"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendAnimationParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendAnimation sends animation files (GIF or H.264/MPEG-4 AVC video without sound).
+func (b *Bot) SendAnimation(ctx context.Context, params SendAnimationParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendAudioParams represents the params of SendAudio.
+type SendAudioParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	Audio                interface{}      `json:"audio"`
+	Caption              string           `json:"caption,omitempty"`
+	ParseMode            string           `json:"parse_mode,omitempty"`
+	CaptionEntities      []MessageEntity  `json:"caption_entities,omitempty"`
+	Duration             int              `json:"duration,omitempty"`
+	Performer            string           `json:"performer,omitempty"`
+	Title                string           `json:"title,omitempty"`
+	Thumbnail            interface{}      `json:"thumbnail,omitempty"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendAudio".
+func (SendAudioParams) Method() string {
+	return "sendAudio"
+}
+
+func (p SendAudioParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendAudio", p, []string{"audio", "thumbnail"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendAudioParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendAudio sends audio files, if you want Telegram clients to display them in the music player.
+func (b *Bot) SendAudio(ctx context.Context, params SendAudioParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendChatActionParams represents the params of SendChatAction.
+type SendChatActionParams struct {
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	ChatID               ChatID `json:"chat_id"`
+	MessageThreadID      int    `json:"message_thread_id,omitempty"`
+	Action               string `json:"action"`
+}
+
+// Method returns "sendChatAction".
+func (SendChatActionParams) Method() string {
+	return "sendChatAction"
+}
+
+func (p SendChatActionParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "sendChatAction", p, nil, nil)
+}
+
+func (p SendChatActionParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SendContactParams represents the params of SendContact.
+type SendContactParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	PhoneNumber          string           `json:"phone_number"`
+	FirstName            string           `json:"first_name"`
+	LastName             string           `json:"last_name,omitempty"`
+	VCard                string           `json:"vcard,omitempty"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendContact".
+func (SendContactParams) Method() string {
+	return "sendContact"
+}
+
+func (p SendContactParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendContact", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendContactParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendContact sends phone contacts.
+func (b *Bot) SendContact(ctx context.Context, params SendContactParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendDiceParams represents the params of SendDice.
+type SendDiceParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	Emoji                string           `json:"emoji,omitempty"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendDice".
+func (SendDiceParams) Method() string {
+	return "sendDice"
+}
+
+func (p SendDiceParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendDice", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendDiceParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendDice sends an animated emoji that will display a random value.
+func (b *Bot) SendDice(ctx context.Context, params SendDiceParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendDocumentParams represents the params of SendDocument.
+type SendDocumentParams struct {
+	BusinessConnectionID        string           `json:"business_connection_id,omitempty"`
+	ChatID                      ChatID           `json:"chat_id"`
+	MessageThreadID             int              `json:"message_thread_id,omitempty"`
+	Document                    interface{}      `json:"document"`
+	Thumbnail                   interface{}      `json:"thumbnail,omitempty"`
+	Caption                     string           `json:"caption,omitempty"`
+	ParseMode                   string           `json:"parse_mode,omitempty"`
+	CaptionEntities             []MessageEntity  `json:"caption_entities,omitempty"`
+	DisableContentTypeDetection bool             `json:"disable_content_type_detection,omitempty"`
+	DisableNotification         bool             `json:"disable_notification,omitempty"`
+	ProtectContent              bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast          bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID             string           `json:"message_effect_id,omitempty"`
+	ReplyParameters             *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup                 ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendDocument".
+func (SendDocumentParams) Method() string {
+	return "sendDocument"
+}
+
+func (p SendDocumentParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendDocument", p, []string{"document", "thumbnail"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendDocumentParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendDocument sends general files.
+func (b *Bot) SendDocument(ctx context.Context, params SendDocumentParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendGameParams represents the params of SendGame.
+type SendGameParams struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               int64                 `json:"chat_id"`
+	MessageThreadID      int                   `json:"message_thread_id,omitempty"`
+	GameShortName        string                `json:"game_short_name"`
+	DisableNotification  bool                  `json:"disable_notification,omitempty"`
+	ProtectContent       bool                  `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool                  `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string                `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters      `json:"reply_parameters,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendGame".
+func (SendGameParams) Method() string {
+	return "sendGame"
+}
+
+func (p SendGameParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendGame", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendGameParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendGame sends a game.
+func (b *Bot) SendGame(ctx context.Context, params SendGameParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendInvoiceParams represents the params of SendInvoice.
+type SendInvoiceParams struct {
+	ChatID                    ChatID                `json:"chat_id"`
+	MessageThreadID           int                   `json:"message_thread_id,omitempty"`
+	Title                     string                `json:"title"`
+	Description               string                `json:"description"`
+	Payload                   string                `json:"payload"`
+	ProviderToken             string                `json:"provider_token,omitempty"`
+	Currency                  string                `json:"currency"`
+	Prices                    []LabeledPrice        `json:"prices"`
+	MaxTipAmount              int                   `json:"max_tip_amount,omitempty"`
+	SuggestedTipAmounts       []int                 `json:"suggested_tip_amounts,omitempty"`
+	StartParameter            string                `json:"start_parameter,omitempty"`
+	ProviderData              string                `json:"provider_data,omitempty"`
+	PhotoURL                  string                `json:"photo_url,omitempty"`
+	PhotoSize                 int                   `json:"photo_size,omitempty"`
+	PhotoWidth                int                   `json:"photo_width,omitempty"`
+	PhotoHeight               int                   `json:"photo_height,omitempty"`
+	NeedName                  bool                  `json:"need_name,omitempty"`
+	NeedPhoneNumber           bool                  `json:"need_phone_number,omitempty"`
+	NeedEmail                 bool                  `json:"need_email,omitempty"`
+	NeedShippingAddress       bool                  `json:"need_shipping_address,omitempty"`
+	SendPhoneNumberToProvider bool                  `json:"send_phone_number_to_provider,omitempty"`
+	SendEmailToProvider       bool                  `json:"send_email_to_provider,omitempty"`
+	IsFlexible                bool                  `json:"is_flexible,omitempty"`
+	DisableNotification       bool                  `json:"disable_notification,omitempty"`
+	ProtectContent            bool                  `json:"protect_content,omitempty"`
+	AllowPaidBroadcast        bool                  `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID           string                `json:"message_effect_id,omitempty"`
+	ReplyParameters           *ReplyParameters      `json:"reply_parameters,omitempty"`
+	ReplyMarkup               *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendInvoice".
+func (SendInvoiceParams) Method() string {
+	return "sendInvoice"
+}
+
+func (p SendInvoiceParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendInvoice", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendInvoiceParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendLocationParams represents the params of SendLocation.
+type SendLocationParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	Latitude             float64          `json:"latitude"`
+	Longitude            float64          `json:"longitude"`
+	HorizontalAccuracy   float64          `json:"horizontal_accuracy,omitempty"`
+	LivePeriod           int              `json:"live_period,omitempty"`
+	Heading              int              `json:"heading,omitempty"`
+	ProximityAlertRadius int              `json:"proximity_alert_radius,omitempty"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendLocation".
+func (SendLocationParams) Method() string {
+	return "sendLocation"
+}
+
+func (p SendLocationParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendLocation", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendLocationParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendLocation sends point on the map.
+func (b *Bot) SendLocation(ctx context.Context, params SendLocationParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendMediaGroupParams represents the params of SendMediaGroup.
+type SendMediaGroupParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	Media                []InputMedia     `json:"media"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+}
+
+// Method returns "sendMediaGroup".
+func (SendMediaGroupParams) Method() string {
+	return "sendMediaGroup"
+}
+
+func (p SendMediaGroupParams) do(ctx context.Context, b *Bot) ([]Message, error) {
+	var result []Message
+	err := b.makeParamsRequest(ctx, "sendMediaGroup", p, nil, &result)
+	return result, err
+}
+
+func (p SendMediaGroupParams) call(ctx context.Context, b *Bot) ([]Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendMediaGroup sends a group of photos, videos, documents or audios as an album.
+func (b *Bot) SendMediaGroup(ctx context.Context, params SendMediaGroupParams) ([]Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendMessageParams represents the params of SendMessage.
+type SendMessageParams struct {
+	BusinessConnectionID string              `json:"business_connection_id,omitempty"`
+	ChatID               ChatID              `json:"chat_id"`
+	MessageThreadID      int                 `json:"message_thread_id,omitempty"`
+	Text                 string              `json:"text"`
+	ParseMode            string              `json:"parse_mode,omitempty"`
+	Entities             []MessageEntity     `json:"entities,omitempty"`
+	LinkPreviewOptions   *LinkPreviewOptions `json:"link_preview_options,omitempty"`
+	DisableNotification  bool                `json:"disable_notification,omitempty"`
+	ProtectContent       bool                `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool                `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string              `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters    `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup         `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendMessage".
+func (SendMessageParams) Method() string {
+	return "sendMessage"
+}
+
+func (p SendMessageParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendMessage", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendMessageParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendPaidMediaParams represents the params of SendPaidMedia.
+type SendPaidMediaParams struct {
+	BusinessConnectionID  string           `json:"business_connection_id,omitempty"`
+	ChatID                ChatID           `json:"chat_id"`
+	StarCount             int              `json:"star_count"`
+	Media                 []InputPaidMedia `json:"media"`
+	Payload               string           `json:"payload,omitempty"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             string           `json:"parse_mode,omitempty"`
+	CaptionEntities       []MessageEntity  `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	DisableNotification   bool             `json:"disable_notification,omitempty"`
+	ProtectContent        bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast    bool             `json:"allow_paid_broadcast,omitempty"`
+	ReplyParameters       *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup           ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendPaidMedia".
+func (SendPaidMediaParams) Method() string {
+	return "sendPaidMedia"
+}
+
+func (p SendPaidMediaParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendPaidMedia", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendPaidMediaParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendPaidMedia sends paid media.
+func (b *Bot) SendPaidMedia(ctx context.Context, params SendPaidMediaParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendPhotoParams represents the params of SendPhoto.
+type SendPhotoParams struct {
+	BusinessConnectionID  string           `json:"business_connection_id,omitempty"`
+	ChatID                ChatID           `json:"chat_id"`
+	MessageThreadID       int              `json:"message_thread_id,omitempty"`
+	Photo                 interface{}      `json:"photo"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             string           `json:"parse_mode,omitempty"`
+	CaptionEntities       []MessageEntity  `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`
+	DisableNotification   bool             `json:"disable_notification,omitempty"`
+	ProtectContent        bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast    bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID       string           `json:"message_effect_id,omitempty"`
+	ReplyParameters       *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup           ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendPhoto".
+func (SendPhotoParams) Method() string {
+	return "sendPhoto"
+}
+
+func (p SendPhotoParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendPhoto", p, []string{"photo"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendPhotoParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendPollParams represents the params of SendPoll.
+type SendPollParams struct {
+	BusinessConnectionID  string            `json:"business_connection_id,omitempty"`
+	ChatID                ChatID            `json:"chat_id"`
+	MessageThreadID       int               `json:"message_thread_id,omitempty"`
+	Question              string            `json:"question"`
+	QuestionParseMode     string            `json:"question_parse_mode,omitempty"`
+	QuestionEntities      []MessageEntity   `json:"question_entities,omitempty"`
+	Options               []InputPollOption `json:"options"`
+	IsAnonymous           *bool             `json:"is_anonymous,omitempty"`
+	Type                  string            `json:"type,omitempty"`
+	AllowsMultipleAnswers bool              `json:"allows_multiple_answers,omitempty"`
+	CorrectOptionID       *int              `json:"correct_option_id,omitempty"`
+	Explanation           string            `json:"explanation,omitempty"`
+	ExplanationParseMode  string            `json:"explanation_parse_mode,omitempty"`
+	ExplanationEntities   []MessageEntity   `json:"explanation_entities,omitempty"`
+	OpenPeriod            int               `json:"open_period,omitempty"`
+	CloseDate             int               `json:"close_date,omitempty"`
+	IsClosed              bool              `json:"is_closed,omitempty"`
+	DisableNotification   bool              `json:"disable_notification,omitempty"`
+	ProtectContent        bool              `json:"protect_content,omitempty"`
+	AllowPaidBroadcast    bool              `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID       string            `json:"message_effect_id,omitempty"`
+	ReplyParameters       *ReplyParameters  `json:"reply_parameters,omitempty"`
+	ReplyMarkup           ReplyMarkup       `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendPoll".
+func (SendPollParams) Method() string {
+	return "sendPoll"
+}
+
+func (p SendPollParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendPoll", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendPollParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendStickerParams represents the params of SendSticker.
+type SendStickerParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	Sticker              interface{}      `json:"sticker"`
+	Emoji                string           `json:"emoji,omitempty"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendSticker".
+func (SendStickerParams) Method() string {
+	return "sendSticker"
+}
+
+func (p SendStickerParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendSticker", p, []string{"sticker"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendStickerParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendSticker sends static .WEBP, animated .TGS, or video .WEBM stickers.
+func (b *Bot) SendSticker(ctx context.Context, params SendStickerParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendVenueParams represents the params of SendVenue.
+type SendVenueParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	Latitude             float64          `json:"latitude"`
+	Longitude            float64          `json:"longitude"`
+	Title                string           `json:"title"`
+	Address              string           `json:"address"`
+	FoursquareID         string           `json:"foursquare_id,omitempty"`
+	FoursquareType       string           `json:"foursquare_type,omitempty"`
+	GooglePlaceID        string           `json:"google_place_id,omitempty"`
+	GooglePlaceType      string           `json:"google_place_type,omitempty"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendVenue".
+func (SendVenueParams) Method() string {
+	return "sendVenue"
+}
+
+func (p SendVenueParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendVenue", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendVenueParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendVenue sends information about a venue.
+func (b *Bot) SendVenue(ctx context.Context, params SendVenueParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendVideoParams represents the params of SendVideo.
+type SendVideoParams struct {
+	BusinessConnectionID  string           `json:"business_connection_id,omitempty"`
+	ChatID                ChatID           `json:"chat_id"`
+	MessageThreadID       int              `json:"message_thread_id,omitempty"`
+	Video                 interface{}      `json:"video"`
+	Duration              int              `json:"duration,omitempty"`
+	Width                 int              `json:"width,omitempty"`
+	Height                int              `json:"height,omitempty"`
+	Thumbnail             interface{}      `json:"thumbnail,omitempty"`
+	Caption               string           `json:"caption,omitempty"`
+	ParseMode             string           `json:"parse_mode,omitempty"`
+	CaptionEntities       []MessageEntity  `json:"caption_entities,omitempty"`
+	ShowCaptionAboveMedia bool             `json:"show_caption_above_media,omitempty"`
+	HasSpoiler            bool             `json:"has_spoiler,omitempty"`
+	SupportsStreaming     bool             `json:"supports_streaming,omitempty"`
+	DisableNotification   bool             `json:"disable_notification,omitempty"`
+	ProtectContent        bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast    bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID       string           `json:"message_effect_id,omitempty"`
+	ReplyParameters       *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup           ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendVideo".
+func (SendVideoParams) Method() string {
+	return "sendVideo"
+}
+
+func (p SendVideoParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendVideo", p, []string{"video", "thumbnail"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendVideoParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendVideo sends video files, Telegram clients support MPEG4 videos (other formats may be sent as Document).
+func (b *Bot) SendVideo(ctx context.Context, params SendVideoParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendVideoNoteParams represents the params of SendVideoNote.
+type SendVideoNoteParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	VideoNote            interface{}      `json:"video_note"`
+	Duration             int              `json:"duration,omitempty"`
+	Length               int              `json:"length,omitempty"`
+	Thumbnail            interface{}      `json:"thumbnail,omitempty"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendVideoNote".
+func (SendVideoNoteParams) Method() string {
+	return "sendVideoNote"
+}
+
+func (p SendVideoNoteParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendVideoNote", p, []string{"video_note", "thumbnail"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendVideoNoteParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendVideoNote as of v.4.0, Telegram clients support rounded square MPEG4 videos of up to 1 minute long.
+func (b *Bot) SendVideoNote(ctx context.Context, params SendVideoNoteParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SendVoiceParams represents the params of SendVoice.
+type SendVoiceParams struct {
+	BusinessConnectionID string           `json:"business_connection_id,omitempty"`
+	ChatID               ChatID           `json:"chat_id"`
+	MessageThreadID      int              `json:"message_thread_id,omitempty"`
+	Voice                interface{}      `json:"voice"`
+	Caption              string           `json:"caption,omitempty"`
+	ParseMode            string           `json:"parse_mode,omitempty"`
+	CaptionEntities      []MessageEntity  `json:"caption_entities,omitempty"`
+	Duration             int              `json:"duration,omitempty"`
+	DisableNotification  bool             `json:"disable_notification,omitempty"`
+	ProtectContent       bool             `json:"protect_content,omitempty"`
+	AllowPaidBroadcast   bool             `json:"allow_paid_broadcast,omitempty"`
+	MessageEffectID      string           `json:"message_effect_id,omitempty"`
+	ReplyParameters      *ReplyParameters `json:"reply_parameters,omitempty"`
+	ReplyMarkup          ReplyMarkup      `json:"reply_markup,omitempty"`
+}
+
+// Method returns "sendVoice".
+func (SendVoiceParams) Method() string {
+	return "sendVoice"
+}
+
+func (p SendVoiceParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result Message
+	if err := b.makeParamsRequest(ctx, "sendVoice", p, []string{"voice"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p SendVoiceParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SendVoice sends audio files, if you want Telegram clients to display the file as a playable voice message.
+func (b *Bot) SendVoice(ctx context.Context, params SendVoiceParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SetChatAdministratorCustomTitleParams represents the params of SetChatAdministratorCustomTitle.
+type SetChatAdministratorCustomTitleParams struct {
+	ChatID      ChatID `json:"chat_id"`
+	UserID      int64  `json:"user_id"`
+	CustomTitle string `json:"custom_title"`
+}
+
+// Method returns "setChatAdministratorCustomTitle".
+func (SetChatAdministratorCustomTitleParams) Method() string {
+	return "setChatAdministratorCustomTitle"
+}
+
+func (p SetChatAdministratorCustomTitleParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setChatAdministratorCustomTitle", p, nil, nil)
+}
+
+func (p SetChatAdministratorCustomTitleParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetChatDescriptionParams represents the params of SetChatDescription.
+type SetChatDescriptionParams struct {
+	ChatID      ChatID `json:"chat_id"`
+	Description string `json:"description,omitempty"`
+}
+
+// Method returns "setChatDescription".
+func (SetChatDescriptionParams) Method() string {
+	return "setChatDescription"
+}
+
+func (p SetChatDescriptionParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setChatDescription", p, nil, nil)
+}
+
+func (p SetChatDescriptionParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetChatMenuButtonParams represents the params of SetChatMenuButton.
+type SetChatMenuButtonParams struct {
+	ChatID     int64       `json:"chat_id,omitempty"`
+	MenuButton *MenuButton `json:"menu_button,omitempty"`
+}
+
+// Method returns "setChatMenuButton".
+func (SetChatMenuButtonParams) Method() string {
+	return "setChatMenuButton"
+}
+
+func (p SetChatMenuButtonParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setChatMenuButton", p, nil, nil)
+}
+
+func (p SetChatMenuButtonParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetChatMenuButton changes the bot's menu button in a private chat, or the default menu button.
+func (b *Bot) SetChatMenuButton(ctx context.Context, params SetChatMenuButtonParams) error {
+	return params.do(ctx, b)
+}
+
+// SetChatPermissionsParams represents the params of SetChatPermissions.
+type SetChatPermissionsParams struct {
+	ChatID                        ChatID           `json:"chat_id"`
+	Permissions                   *ChatPermissions `json:"permissions"`
+	UseIndependentChatPermissions bool             `json:"use_independent_chat_permissions,omitempty"`
+}
+
+// Method returns "setChatPermissions".
+func (SetChatPermissionsParams) Method() string {
+	return "setChatPermissions"
+}
+
+func (p SetChatPermissionsParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setChatPermissions", p, nil, nil)
+}
+
+func (p SetChatPermissionsParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetChatPhotoParams represents the params of SetChatPhoto.
+type SetChatPhotoParams struct {
+	ChatID ChatID      `json:"chat_id"`
+	Photo  interface{} `json:"photo"`
+}
+
+// Method returns "setChatPhoto".
+func (SetChatPhotoParams) Method() string {
+	return "setChatPhoto"
+}
+
+func (p SetChatPhotoParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setChatPhoto", p, []string{"photo"}, nil)
+}
+
+func (p SetChatPhotoParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetChatStickerSetParams represents the params of SetChatStickerSet.
+type SetChatStickerSetParams struct {
+	ChatID         ChatID `json:"chat_id"`
+	StickerSetName string `json:"sticker_set_name"`
+}
+
+// Method returns "setChatStickerSet".
+func (SetChatStickerSetParams) Method() string {
+	return "setChatStickerSet"
+}
+
+func (p SetChatStickerSetParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setChatStickerSet", p, nil, nil)
+}
+
+func (p SetChatStickerSetParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetChatStickerSet sets a new group sticker set for a supergroup.
+func (b *Bot) SetChatStickerSet(ctx context.Context, params SetChatStickerSetParams) error {
+	return params.do(ctx, b)
+}
+
+// SetChatTitleParams represents the params of SetChatTitle.
+type SetChatTitleParams struct {
+	ChatID ChatID `json:"chat_id"`
+	Title  string `json:"title"`
+}
+
+// Method returns "setChatTitle".
+func (SetChatTitleParams) Method() string {
+	return "setChatTitle"
+}
+
+func (p SetChatTitleParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setChatTitle", p, nil, nil)
+}
+
+func (p SetChatTitleParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetCustomEmojiStickerSetThumbnailParams represents the params of SetCustomEmojiStickerSetThumbnail.
+type SetCustomEmojiStickerSetThumbnailParams struct {
+	Name          string `json:"name"`
+	CustomEmojiID string `json:"custom_emoji_id,omitempty"`
+}
+
+// Method returns "setCustomEmojiStickerSetThumbnail".
+func (SetCustomEmojiStickerSetThumbnailParams) Method() string {
+	return "setCustomEmojiStickerSetThumbnail"
+}
+
+func (p SetCustomEmojiStickerSetThumbnailParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setCustomEmojiStickerSetThumbnail", p, nil, nil)
+}
+
+func (p SetCustomEmojiStickerSetThumbnailParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetCustomEmojiStickerSetThumbnail sets the thumbnail of a custom emoji sticker set.
+func (b *Bot) SetCustomEmojiStickerSetThumbnail(ctx context.Context, params SetCustomEmojiStickerSetThumbnailParams) error {
+	return params.do(ctx, b)
+}
+
+// SetGameScoreParams represents the params of SetGameScore.
+type SetGameScoreParams struct {
+	UserID             int64  `json:"user_id"`
+	Score              int    `json:"score"`
+	Force              bool   `json:"force,omitempty"`
+	DisableEditMessage bool   `json:"disable_edit_message,omitempty"`
+	ChatID             int64  `json:"chat_id,omitempty"`
+	MessageID          int    `json:"message_id,omitempty"`
+	InlineMessageID    string `json:"inline_message_id,omitempty"`
+}
+
+// Method returns "setGameScore".
+func (SetGameScoreParams) Method() string {
+	return "setGameScore"
+}
+
+func (p SetGameScoreParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result messageOrTrue
+	if err := b.makeParamsRequest(ctx, "setGameScore", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Message, nil
+}
+
+func (p SetGameScoreParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// SetGameScore sets the score of the specified user in a game message.
+func (b *Bot) SetGameScore(ctx context.Context, params SetGameScoreParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// SetMessageReactionParams represents the params of SetMessageReaction.
+type SetMessageReactionParams struct {
+	ChatID    ChatID         `json:"chat_id"`
+	MessageID int            `json:"message_id"`
+	Reaction  []ReactionType `json:"reaction,omitempty"`
+	IsBig     bool           `json:"is_big,omitempty"`
+}
+
+// Method returns "setMessageReaction".
+func (SetMessageReactionParams) Method() string {
+	return "setMessageReaction"
+}
+
+func (p SetMessageReactionParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setMessageReaction", p, nil, nil)
+}
+
+func (p SetMessageReactionParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetMessageReaction changes the chosen reactions on a message.
+func (b *Bot) SetMessageReaction(ctx context.Context, params SetMessageReactionParams) error {
+	return params.do(ctx, b)
+}
+
+// SetMyCommandsParams represents the params of SetMyCommands.
+type SetMyCommandsParams struct {
+	Commands     []BotCommand     `json:"commands"`
+	Scope        *BotCommandScope `json:"scope,omitempty"`
+	LanguageCode string           `json:"language_code,omitempty"`
+}
+
+// Method returns "setMyCommands".
+func (SetMyCommandsParams) Method() string {
+	return "setMyCommands"
+}
+
+func (p SetMyCommandsParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setMyCommands", p, nil, nil)
+}
+
+func (p SetMyCommandsParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetMyCommands changes the list of the bot's commands.
+func (b *Bot) SetMyCommands(ctx context.Context, params SetMyCommandsParams) error {
+	return params.do(ctx, b)
+}
+
+// SetMyDefaultAdministratorRightsParams represents the params of SetMyDefaultAdministratorRights.
+type SetMyDefaultAdministratorRightsParams struct {
+	Rights      *ChatAdministratorRights `json:"rights,omitempty"`
+	ForChannels bool                     `json:"for_channels,omitempty"`
+}
+
+// Method returns "setMyDefaultAdministratorRights".
+func (SetMyDefaultAdministratorRightsParams) Method() string {
+	return "setMyDefaultAdministratorRights"
+}
+
+func (p SetMyDefaultAdministratorRightsParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setMyDefaultAdministratorRights", p, nil, nil)
+}
+
+func (p SetMyDefaultAdministratorRightsParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetMyDefaultAdministratorRights changes the default administrator rights requested by the bot when it's added as an administrator to groups or channels.
+func (b *Bot) SetMyDefaultAdministratorRights(ctx context.Context, params SetMyDefaultAdministratorRightsParams) error {
+	return params.do(ctx, b)
+}
+
+// SetMyDescriptionParams represents the params of SetMyDescription.
+type SetMyDescriptionParams struct {
+	Description  *string `json:"description,omitempty"`
+	LanguageCode string  `json:"language_code,omitempty"`
+}
+
+// Method returns "setMyDescription".
+func (SetMyDescriptionParams) Method() string {
+	return "setMyDescription"
+}
+
+func (p SetMyDescriptionParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setMyDescription", p, nil, nil)
+}
+
+func (p SetMyDescriptionParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetMyDescription changes the bot's description, which is shown in the chat with the bot if the chat is empty.
+func (b *Bot) SetMyDescription(ctx context.Context, params SetMyDescriptionParams) error {
+	return params.do(ctx, b)
+}
+
+// SetMyNameParams represents the params of SetMyName.
+type SetMyNameParams struct {
+	Name         *string `json:"name,omitempty"`
+	LanguageCode string  `json:"language_code,omitempty"`
+}
+
+// Method returns "setMyName".
+func (SetMyNameParams) Method() string {
+	return "setMyName"
+}
+
+func (p SetMyNameParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setMyName", p, nil, nil)
+}
+
+func (p SetMyNameParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetMyName changes the bot's name.
+func (b *Bot) SetMyName(ctx context.Context, params SetMyNameParams) error {
+	return params.do(ctx, b)
+}
+
+// SetMyShortDescriptionParams represents the params of SetMyShortDescription.
+type SetMyShortDescriptionParams struct {
+	ShortDescription *string `json:"short_description,omitempty"`
+	LanguageCode     string  `json:"language_code,omitempty"`
+}
+
+// Method returns "setMyShortDescription".
+func (SetMyShortDescriptionParams) Method() string {
+	return "setMyShortDescription"
+}
+
+func (p SetMyShortDescriptionParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setMyShortDescription", p, nil, nil)
+}
+
+func (p SetMyShortDescriptionParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetMyShortDescription changes the bot's short description, which is shown on the bot's profile page and is sent together with the link when users share the bot.
+func (b *Bot) SetMyShortDescription(ctx context.Context, params SetMyShortDescriptionParams) error {
+	return params.do(ctx, b)
+}
+
+// SetPassportDataErrorsParams represents the params of SetPassportDataErrors.
+type SetPassportDataErrorsParams struct {
+	UserID int64                  `json:"user_id"`
+	Errors []PassportElementError `json:"errors"`
+}
+
+// Method returns "setPassportDataErrors".
+func (SetPassportDataErrorsParams) Method() string {
+	return "setPassportDataErrors"
+}
+
+func (p SetPassportDataErrorsParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setPassportDataErrors", p, nil, nil)
+}
+
+func (p SetPassportDataErrorsParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetPassportDataErrors informs a user that some of the Telegram Passport elements they provided contains errors.
+func (b *Bot) SetPassportDataErrors(ctx context.Context, params SetPassportDataErrorsParams) error {
+	return params.do(ctx, b)
+}
+
+// SetStickerEmojiListParams represents the params of SetStickerEmojiList.
+type SetStickerEmojiListParams struct {
+	Sticker   string   `json:"sticker"`
+	EmojiList []string `json:"emoji_list"`
+}
+
+// Method returns "setStickerEmojiList".
+func (SetStickerEmojiListParams) Method() string {
+	return "setStickerEmojiList"
+}
+
+func (p SetStickerEmojiListParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setStickerEmojiList", p, nil, nil)
+}
+
+func (p SetStickerEmojiListParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetStickerEmojiList changes the list of emoji assigned to a regular or custom emoji sticker.
+func (b *Bot) SetStickerEmojiList(ctx context.Context, params SetStickerEmojiListParams) error {
+	return params.do(ctx, b)
+}
+
+// SetStickerKeywordsParams represents the params of SetStickerKeywords.
+type SetStickerKeywordsParams struct {
+	Sticker  string   `json:"sticker"`
+	Keywords []string `json:"keywords,omitempty"`
+}
+
+// Method returns "setStickerKeywords".
+func (SetStickerKeywordsParams) Method() string {
+	return "setStickerKeywords"
+}
+
+func (p SetStickerKeywordsParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setStickerKeywords", p, nil, nil)
+}
+
+func (p SetStickerKeywordsParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetStickerKeywords changes search keywords assigned to a regular or custom emoji sticker.
+func (b *Bot) SetStickerKeywords(ctx context.Context, params SetStickerKeywordsParams) error {
+	return params.do(ctx, b)
+}
+
+// SetStickerMaskPositionParams represents the params of SetStickerMaskPosition.
+type SetStickerMaskPositionParams struct {
+	Sticker      string        `json:"sticker"`
+	MaskPosition *MaskPosition `json:"mask_position,omitempty"`
+}
+
+// Method returns "setStickerMaskPosition".
+func (SetStickerMaskPositionParams) Method() string {
+	return "setStickerMaskPosition"
+}
+
+func (p SetStickerMaskPositionParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setStickerMaskPosition", p, nil, nil)
+}
+
+func (p SetStickerMaskPositionParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetStickerMaskPosition changes the mask position of a mask sticker.
+func (b *Bot) SetStickerMaskPosition(ctx context.Context, params SetStickerMaskPositionParams) error {
+	return params.do(ctx, b)
+}
+
+// SetStickerPositionInSetParams represents the params of SetStickerPositionInSet.
+type SetStickerPositionInSetParams struct {
+	Sticker  string `json:"sticker"`
+	Position int    `json:"position"`
+}
+
+// Method returns "setStickerPositionInSet".
+func (SetStickerPositionInSetParams) Method() string {
+	return "setStickerPositionInSet"
+}
+
+func (p SetStickerPositionInSetParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setStickerPositionInSet", p, nil, nil)
+}
+
+func (p SetStickerPositionInSetParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetStickerPositionInSet moves a sticker in a set created by the bot to a specific position.
+func (b *Bot) SetStickerPositionInSet(ctx context.Context, params SetStickerPositionInSetParams) error {
+	return params.do(ctx, b)
+}
+
+// SetStickerSetThumbnailParams represents the params of SetStickerSetThumbnail.
+type SetStickerSetThumbnailParams struct {
+	Name      string      `json:"name"`
+	UserID    int64       `json:"user_id"`
+	Thumbnail interface{} `json:"thumbnail,omitempty"`
+	Format    string      `json:"format"`
+}
+
+// Method returns "setStickerSetThumbnail".
+func (SetStickerSetThumbnailParams) Method() string {
+	return "setStickerSetThumbnail"
+}
+
+func (p SetStickerSetThumbnailParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setStickerSetThumbnail", p, []string{"thumbnail"}, nil)
+}
+
+func (p SetStickerSetThumbnailParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetStickerSetThumbnail sets the thumbnail of a regular or mask sticker set.
+func (b *Bot) SetStickerSetThumbnail(ctx context.Context, params SetStickerSetThumbnailParams) error {
+	return params.do(ctx, b)
+}
+
+// SetStickerSetTitleParams represents the params of SetStickerSetTitle.
+type SetStickerSetTitleParams struct {
+	Name  string `json:"name"`
+	Title string `json:"title"`
+}
+
+// Method returns "setStickerSetTitle".
+func (SetStickerSetTitleParams) Method() string {
+	return "setStickerSetTitle"
+}
+
+func (p SetStickerSetTitleParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setStickerSetTitle", p, nil, nil)
+}
+
+func (p SetStickerSetTitleParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// SetStickerSetTitle sets the title of a created sticker set.
+func (b *Bot) SetStickerSetTitle(ctx context.Context, params SetStickerSetTitleParams) error {
+	return params.do(ctx, b)
+}
+
+// SetWebhookParams represents the params of SetWebhook.
+type SetWebhookParams struct {
+	URL                string      `json:"url"`
+	Certificate        interface{} `json:"certificate,omitempty"`
+	IPAddress          string      `json:"ip_address,omitempty"`
+	MaxConnections     *int        `json:"max_connections,omitempty"`
+	AllowedUpdates     []string    `json:"allowed_updates,omitempty"`
+	DropPendingUpdates bool        `json:"drop_pending_updates,omitempty"`
+	SecretToken        string      `json:"secret_token,omitempty"`
+}
+
+// Method returns "setWebhook".
+func (SetWebhookParams) Method() string {
+	return "setWebhook"
+}
+
+func (p SetWebhookParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "setWebhook", p, []string{"certificate"}, nil)
+}
+
+func (p SetWebhookParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// StopMessageLiveLocationParams represents the params of StopMessageLiveLocation.
+type StopMessageLiveLocationParams struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               ChatID                `json:"chat_id,omitempty"`
+	MessageID            int                   `json:"message_id,omitempty"`
+	InlineMessageID      string                `json:"inline_message_id,omitempty"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "stopMessageLiveLocation".
+func (StopMessageLiveLocationParams) Method() string {
+	return "stopMessageLiveLocation"
+}
+
+func (p StopMessageLiveLocationParams) do(ctx context.Context, b *Bot) (*Message, error) {
+	var result messageOrTrue
+	if err := b.makeParamsRequest(ctx, "stopMessageLiveLocation", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Message, nil
+}
+
+func (p StopMessageLiveLocationParams) call(ctx context.Context, b *Bot) (*Message, error) {
+	return p.do(ctx, b)
+}
+
+// StopMessageLiveLocation stops updating a live location message before live_period expires.
+func (b *Bot) StopMessageLiveLocation(ctx context.Context, params StopMessageLiveLocationParams) (*Message, error) {
+	return params.do(ctx, b)
+}
+
+// StopPollParams represents the params of StopPoll.
+type StopPollParams struct {
+	BusinessConnectionID string                `json:"business_connection_id,omitempty"`
+	ChatID               ChatID                `json:"chat_id"`
+	MessageID            int                   `json:"message_id"`
+	ReplyMarkup          *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+// Method returns "stopPoll".
+func (StopPollParams) Method() string {
+	return "stopPoll"
+}
+
+func (p StopPollParams) do(ctx context.Context, b *Bot) (*Poll, error) {
+	var result Poll
+	if err := b.makeParamsRequest(ctx, "stopPoll", p, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p StopPollParams) call(ctx context.Context, b *Bot) (*Poll, error) {
+	return p.do(ctx, b)
+}
+
+// UnbanChatMemberParams represents the params of UnbanChatMember.
+type UnbanChatMemberParams struct {
+	ChatID       ChatID `json:"chat_id"`
+	UserID       int64  `json:"user_id"`
+	OnlyIfBanned bool   `json:"only_if_banned,omitempty"`
+}
+
+// Method returns "unbanChatMember".
+func (UnbanChatMemberParams) Method() string {
+	return "unbanChatMember"
+}
+
+func (p UnbanChatMemberParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "unbanChatMember", p, nil, nil)
+}
+
+func (p UnbanChatMemberParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// UnbanChatSenderChatParams represents the params of UnbanChatSenderChat.
+type UnbanChatSenderChatParams struct {
+	ChatID       ChatID `json:"chat_id"`
+	SenderChatID int64  `json:"sender_chat_id"`
+}
+
+// Method returns "unbanChatSenderChat".
+func (UnbanChatSenderChatParams) Method() string {
+	return "unbanChatSenderChat"
+}
+
+func (p UnbanChatSenderChatParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "unbanChatSenderChat", p, nil, nil)
+}
+
+func (p UnbanChatSenderChatParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// UnbanChatSenderChat unbans a previously banned channel chat in a supergroup or channel.
+func (b *Bot) UnbanChatSenderChat(ctx context.Context, params UnbanChatSenderChatParams) error {
+	return params.do(ctx, b)
+}
+
+// UnhideGeneralForumTopicParams represents the params of UnhideGeneralForumTopic.
+type UnhideGeneralForumTopicParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "unhideGeneralForumTopic".
+func (UnhideGeneralForumTopicParams) Method() string {
+	return "unhideGeneralForumTopic"
+}
+
+func (p UnhideGeneralForumTopicParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "unhideGeneralForumTopic", p, nil, nil)
+}
+
+func (p UnhideGeneralForumTopicParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// UnpinAllChatMessagesParams represents the params of UnpinAllChatMessages.
+type UnpinAllChatMessagesParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "unpinAllChatMessages".
+func (UnpinAllChatMessagesParams) Method() string {
+	return "unpinAllChatMessages"
+}
+
+func (p UnpinAllChatMessagesParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "unpinAllChatMessages", p, nil, nil)
+}
+
+func (p UnpinAllChatMessagesParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// UnpinAllForumTopicMessagesParams represents the params of UnpinAllForumTopicMessages.
+type UnpinAllForumTopicMessagesParams struct {
+	ChatID          ChatID `json:"chat_id"`
+	MessageThreadID int    `json:"message_thread_id"`
+}
+
+// Method returns "unpinAllForumTopicMessages".
+func (UnpinAllForumTopicMessagesParams) Method() string {
+	return "unpinAllForumTopicMessages"
+}
+
+func (p UnpinAllForumTopicMessagesParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "unpinAllForumTopicMessages", p, nil, nil)
+}
+
+func (p UnpinAllForumTopicMessagesParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// UnpinAllGeneralForumTopicMessagesParams represents the params of UnpinAllGeneralForumTopicMessages.
+type UnpinAllGeneralForumTopicMessagesParams struct {
+	ChatID ChatID `json:"chat_id"`
+}
+
+// Method returns "unpinAllGeneralForumTopicMessages".
+func (UnpinAllGeneralForumTopicMessagesParams) Method() string {
+	return "unpinAllGeneralForumTopicMessages"
+}
+
+func (p UnpinAllGeneralForumTopicMessagesParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "unpinAllGeneralForumTopicMessages", p, nil, nil)
+}
+
+func (p UnpinAllGeneralForumTopicMessagesParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// UnpinChatMessageParams represents the params of UnpinChatMessage.
+type UnpinChatMessageParams struct {
+	BusinessConnectionID string `json:"business_connection_id,omitempty"`
+	ChatID               ChatID `json:"chat_id"`
+	MessageID            int    `json:"message_id,omitempty"`
+}
+
+// Method returns "unpinChatMessage".
+func (UnpinChatMessageParams) Method() string {
+	return "unpinChatMessage"
+}
+
+func (p UnpinChatMessageParams) do(ctx context.Context, b *Bot) error {
+	return b.makeParamsRequest(ctx, "unpinChatMessage", p, nil, nil)
+}
+
+func (p UnpinChatMessageParams) call(ctx context.Context, b *Bot) (bool, error) {
+	err := p.do(ctx, b)
+	return err == nil, err
+}
+
+// UploadStickerFileParams represents the params of UploadStickerFile.
+type UploadStickerFileParams struct {
+	UserID        int64       `json:"user_id"`
+	Sticker       interface{} `json:"sticker"`
+	StickerFormat string      `json:"sticker_format"`
+}
+
+// Method returns "uploadStickerFile".
+func (UploadStickerFileParams) Method() string {
+	return "uploadStickerFile"
+}
+
+func (p UploadStickerFileParams) do(ctx context.Context, b *Bot) (*File, error) {
+	var result File
+	if err := b.makeParamsRequest(ctx, "uploadStickerFile", p, []string{"sticker"}, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (p UploadStickerFileParams) call(ctx context.Context, b *Bot) (*File, error) {
+	return p.do(ctx, b)
+}
+
+// UploadStickerFile uploads a file with a sticker for later use in the createNewStickerSet, addStickerToSet, or replaceStickerInSet methods (the file can be used multiple times).
+func (b *Bot) UploadStickerFile(ctx context.Context, params UploadStickerFileParams) (*File, error) {
+	return params.do(ctx, b)
+}
@@ -0,0 +1,100 @@
+package gotelegrambot
+
+import (
+	"time"
+)
+
+// Metrics receives measurements about the bot's API calls and update handling.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// APIRequest records a completed API call, including all of its retries.
+	APIRequest(method string, duration time.Duration, err error)
+	// APIRetry records a retry of an API call.
+	APIRetry(method string)
+	// RateLimitWait records a wait requested by Telegram with retry_after.
+	RateLimitWait(method string, wait time.Duration)
+	// UpdateReceived records an update of the given type.
+	UpdateReceived(updateType string)
+	// HandlerStarted records the start of the handling of an update.
+	HandlerStarted(updateType string)
+	// HandlerDone records the end of the handling of an update.
+	HandlerDone(updateType string, duration time.Duration, err error)
+}
+
+// noopMetrics discards all measurements.
+type noopMetrics struct{}
+
+func (noopMetrics) APIRequest(string, time.Duration, error)  {}
+func (noopMetrics) APIRetry(string)                          {}
+func (noopMetrics) RateLimitWait(string, time.Duration)      {}
+func (noopMetrics) UpdateReceived(string)                    {}
+func (noopMetrics) HandlerStarted(string)                    {}
+func (noopMetrics) HandlerDone(string, time.Duration, error) {}
+
+// WithMetrics sets the Metrics the bot reports to.
+func WithMetrics(metrics Metrics) BotOption {
+	return func(b *Bot) {
+		b.metrics = metrics
+	}
+}
+
+// instruments returns the configured Metrics, or one discarding everything.
+func (b *Bot) instruments() Metrics {
+	if b.metrics == nil {
+		return noopMetrics{}
+	}
+	return b.metrics
+}
+
+// UpdateType returns the type of the update, as used in allowed_updates.
+func UpdateType(update *Update) string {
+	switch {
+	case update.Message != nil:
+		return UpdateTypeMessage
+	case update.EditedMessage != nil:
+		return UpdateTypeEditedMessage
+	case update.ChannelPost != nil:
+		return UpdateTypeChannelPost
+	case update.EditedChannelPost != nil:
+		return UpdateTypeEditedChannelPost
+	case update.BusinessConnection != nil:
+		return UpdateTypeBusinessConnection
+	case update.BusinessMessage != nil:
+		return UpdateTypeBusinessMessage
+	case update.EditedBusinessMessage != nil:
+		return UpdateTypeEditedBusinessMessage
+	case update.DeletedBusinessMessages != nil:
+		return UpdateTypeDeletedBusinessMessages
+	case update.MessageReaction != nil:
+		return UpdateTypeMessageReaction
+	case update.MessageReactionCount != nil:
+		return UpdateTypeMessageReactionCount
+	case update.InlineQuery != nil:
+		return UpdateTypeInlineQuery
+	case update.ChosenInlineResult != nil:
+		return UpdateTypeChosenInlineResult
+	case update.CallbackQuery != nil:
+		return UpdateTypeCallbackQuery
+	case update.ShippingQuery != nil:
+		return UpdateTypeShippingQuery
+	case update.PreCheckoutQuery != nil:
+		return UpdateTypePreCheckoutQuery
+	case update.PurchasedPaidMedia != nil:
+		return UpdateTypePurchasedPaidMedia
+	case update.Poll != nil:
+		return UpdateTypePoll
+	case update.PollAnswer != nil:
+		return UpdateTypePollAnswer
+	case update.MyChatMember != nil:
+		return UpdateTypeMyChatMember
+	case update.ChatMember != nil:
+		return UpdateTypeChatMember
+	case update.ChatJoinRequest != nil:
+		return UpdateTypeChatJoinRequest
+	case update.ChatBoost != nil:
+		return UpdateTypeChatBoost
+	case update.RemovedChatBoost != nil:
+		return UpdateTypeRemovedChatBoost
+	}
+	return "unknown"
+}
@@ -0,0 +1,22 @@
+package gotelegrambot
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateType(t *testing.T) {
+	types := append([]string{UpdateTypeChatMember, UpdateTypeMessageReaction, UpdateTypeMessageReactionCount}, defaultUpdateTypes...)
+
+	// Every update type that can be requested decodes into Update
+	for _, updateType := range types {
+		var update Update
+		require.NoError(t, json.Unmarshal([]byte(`{"update_id":1,"`+updateType+`":{}}`), &update))
+		assert.Equal(t, updateType, UpdateType(&update))
+	}
+
+	assert.Equal(t, "unknown", UpdateType(&Update{UpdateID: 1}))
+}
@@ -0,0 +1,94 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// APICall is a single Bot API call as seen by API middlewares.
+type APICall struct {
+	// Method is the Bot API method, such as "sendMessage".
+	Method string
+	// Params are the call's parameters. Middlewares may change them; they
+	// may be nil for calls without parameters.
+	Params map[string]interface{}
+	// Files maps multipart field names to local file paths. It is nil for
+	// calls sent as JSON.
+	Files map[string]string
+	// Header holds extra HTTP headers sent with the call.
+	Header http.Header
+	// Result is the pointer the response is decoded into, or nil when the
+	// response is discarded. It holds the decoded response once the call returns.
+	Result interface{}
+}
+
+// SetResult stores value as the call's decoded response. It is meant for
+// middlewares that answer a call without sending it.
+func (c *APICall) SetResult(value interface{}) error {
+	if c.Result == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal result")
+	}
+
+	if err := json.Unmarshal(data, c.Result); err != nil {
+		return errors.Wrap(err, "failed to set result")
+	}
+	return nil
+}
+
+// APICallHandler sends an API call.
+type APICallHandler func(ctx context.Context, call *APICall) error
+
+// APIMiddleware wraps the sending of API calls. It may change the call before
+// passing it to next, inspect the result afterwards, or return without calling
+// next to short-circuit the call.
+type APIMiddleware func(next APICallHandler) APICallHandler
+
+// WithAPIMiddleware adds middlewares around every API call. The first
+// middleware is the outermost one.
+func WithAPIMiddleware(middlewares ...APIMiddleware) BotOption {
+	return func(b *Bot) {
+		b.middlewares = append(b.middlewares, middlewares...)
+	}
+}
+
+// Use adds middlewares around every API call, after the ones already added.
+func (b *Bot) Use(middlewares ...APIMiddleware) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.middlewares = append(b.middlewares, middlewares...)
+}
+
+// invoke sends the call through the middlewares.
+func (b *Bot) invoke(ctx context.Context, call *APICall) error {
+	b.mu.RLock()
+	middlewares := b.middlewares
+	b.mu.RUnlock()
+
+	handler := APICallHandler(b.execute)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+
+	ctx, span := b.startSpan(ctx, SpanRequest, Attr(AttrMethod, call.Method))
+	defer span.End()
+
+	err := handler(ctx, call)
+	if err != nil {
+		var apiErr *Error
+		if errors.As(err, &apiErr) {
+			span.SetAttributes(Attr(AttrErrorCode, apiErr.Code))
+		}
+		span.RecordError(err)
+	}
+
+	return err
+}
@@ -0,0 +1,68 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIMiddleware(t *testing.T) {
+	var header string
+	var text string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Trace-ID")
+		var params struct {
+			Text string `json:"text"`
+		}
+		json.NewDecoder(r.Body).Decode(&params)
+		text = params.Text
+		w.Write([]byte(`{"ok":true,"result":{"message_id":7,"date":0}}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	var observed int
+	bot, _ := New("test_token", WithAPIMiddleware(
+		func(next APICallHandler) APICallHandler {
+			return func(ctx context.Context, call *APICall) error {
+				order = append(order, "outer")
+				call.Header.Set("X-Trace-ID", "abc")
+				err := next(ctx, call)
+				observed = call.Result.(*Message).MessageID
+				return err
+			}
+		},
+		func(next APICallHandler) APICallHandler {
+			return func(ctx context.Context, call *APICall) error {
+				order = append(order, "inner")
+				call.Params["text"] = "changed"
+				return next(ctx, call)
+			}
+		},
+	))
+	bot.APIEndpoint = server.URL
+
+	_, err := bot.SendMessage(context.Background(), NewChatID(1), "hello")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"outer", "inner"}, order)
+	assert.Equal(t, "abc", header)
+	assert.Equal(t, "changed", text)
+	assert.Equal(t, 7, observed)
+
+	// Short-circuit without reaching the server
+	text = ""
+	bot.Use(func(next APICallHandler) APICallHandler {
+		return func(ctx context.Context, call *APICall) error {
+			return call.SetResult(map[string]interface{}{"message_id": 42, "date": 0})
+		}
+	})
+	message, err := bot.SendMessage(context.Background(), NewChatID(1), "hello")
+	require.NoError(t, err)
+	assert.Equal(t, 42, message.MessageID)
+	assert.Empty(t, text)
+}
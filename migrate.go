@@ -0,0 +1,126 @@
+package gotelegrambot
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ChatMigrationHandler is a function called when a group is migrated to a supergroup.
+type ChatMigrationHandler func(ctx context.Context, fromChatID, toChatID int64) error
+
+// OnChatMigrated registers a handler called once for every group migrated to a
+// supergroup, as long as the migration is among the last thousand seen.
+// Migrations are detected from API errors carrying migrate_to_chat_id and from
+// the migrate_to_chat_id and migrate_from_chat_id service messages.
+func (b *Bot) OnChatMigrated(handler ChatMigrationHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.chatMigrationHandlers = append(b.chatMigrationHandlers, handler)
+}
+
+// runChatMigrationHooks handles the migration service messages of the update.
+func (b *Bot) runChatMigrationHooks(ctx context.Context, update *Update) error {
+	message := update.Message
+	if message == nil || message.Chat == nil {
+		return nil
+	}
+
+	switch {
+	case message.MigrateToChatID != 0:
+		return b.chatMigrated(ctx, message.Chat.ID, message.MigrateToChatID)
+	case message.MigrateFromChatID != 0:
+		return b.chatMigrated(ctx, message.MigrateFromChatID, message.Chat.ID)
+	}
+
+	return nil
+}
+
+// maxMigratedChats is the number of migrations remembered to call the
+// handlers only once. Past it, the oldest migrations are forgotten.
+const maxMigratedChats = 1000
+
+// chatMigrated calls the migration handlers unless the migration was already seen.
+func (b *Bot) chatMigrated(ctx context.Context, fromChatID, toChatID int64) error {
+	b.mu.Lock()
+	if b.migratedChats == nil {
+		b.migratedChats = make(map[int64]int64)
+	}
+	if b.migratedChats[fromChatID] == toChatID {
+		b.mu.Unlock()
+		return nil
+	}
+	if _, seen := b.migratedChats[fromChatID]; !seen {
+		b.migrationOrder = append(b.migrationOrder, fromChatID)
+	}
+	b.migratedChats[fromChatID] = toChatID
+	if len(b.migrationOrder) > maxMigratedChats {
+		delete(b.migratedChats, b.migrationOrder[0])
+		b.migrationOrder = b.migrationOrder[1:]
+	}
+	handlers := b.chatMigrationHandlers
+	b.mu.Unlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, fromChatID, toChatID); err != nil {
+			return errors.Wrap(err, "chat migration handler failed")
+		}
+	}
+
+	return nil
+}
+
+// migrateRequest inspects a failed request for a group migration. It reports the
+// migration to the handlers and, when auto migration is enabled, returns a copy
+// of the params pointing to the new chat.
+func (b *Bot) migrateRequest(ctx context.Context, params interface{}, err error) (map[string]interface{}, bool) {
+	var apiErr *Error
+	if err == nil || !errors.As(err, &apiErr) || apiErr.Parameters == nil || apiErr.Parameters.MigrateToChatID == 0 {
+		return nil, false
+	}
+
+	values, ok := params.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	chatID, ok := values["chat_id"]
+	if !ok {
+		return nil, false
+	}
+
+	toChatID := apiErr.Parameters.MigrateToChatID
+	if fromChatID, ok := int64ChatID(chatID); ok {
+		if err := b.chatMigrated(ctx, fromChatID, toChatID); err != nil {
+			b.debug("Chat migration handler failed: %v", err)
+		}
+	}
+
+	if !b.autoMigrate {
+		return nil, false
+	}
+
+	migrated := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		migrated[key] = value
+	}
+	migrated["chat_id"] = toChatID
+
+	return migrated, true
+}
+
+// int64ChatID converts a numeric chat_id param to an int64.
+func int64ChatID(chatID interface{}) (int64, bool) {
+	switch id := chatID.(type) {
+	case int64:
+		return id, true
+	case int:
+		return int64(id), true
+	case string:
+		n, err := strconv.ParseInt(id, 10, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
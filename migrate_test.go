@@ -0,0 +1,79 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoMigrate(t *testing.T) {
+	var chatIDs []int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params struct {
+			ChatID int64 `json:"chat_id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+		chatIDs = append(chatIDs, params.ChatID)
+
+		if params.ChatID == -100 {
+			w.Write([]byte(`{"ok":false,"error_code":400,"description":"Bad Request: group chat was upgraded to a supergroup chat","parameters":{"migrate_to_chat_id":-100200}}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0}}`))
+	}))
+	defer server.Close()
+
+	bot, _ := New("test_token", WithAutoMigrate(true), WithRetryCount(0))
+	bot.APIEndpoint = server.URL
+
+	var migrations [][2]int64
+	bot.OnChatMigrated(func(ctx context.Context, fromChatID, toChatID int64) error {
+		migrations = append(migrations, [2]int64{fromChatID, toChatID})
+		return nil
+	})
+
+	message, err := bot.SendMessage(context.Background(), NewChatID(-100), "hello")
+	require.NoError(t, err)
+	assert.Equal(t, 1, message.MessageID)
+	assert.Equal(t, []int64{-100, -100200}, chatIDs)
+
+	// The service message for the same migration does not fire the handler again
+	update := &Update{Message: &Message{Chat: &Chat{ID: -100200}, MigrateFromChatID: -100}}
+	require.NoError(t, bot.handleUpdate(context.Background(), update, nil))
+	assert.Equal(t, [][2]int64{{-100, -100200}}, migrations)
+
+	// Without auto migration the error is returned as is
+	bot.autoMigrate = false
+	_, err = bot.SendMessage(context.Background(), NewChatID(-100), "hello")
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, int64(-100200), apiErr.Parameters.MigrateToChatID)
+}
+
+func TestMigratedChatsCap(t *testing.T) {
+	bot, _ := New("test_token")
+
+	calls := 0
+	bot.OnChatMigrated(func(ctx context.Context, fromChatID, toChatID int64) error {
+		calls++
+		return nil
+	})
+
+	ctx := context.Background()
+	for i := int64(1); i <= maxMigratedChats+1; i++ {
+		require.NoError(t, bot.chatMigrated(ctx, -i, -1000-i))
+	}
+	assert.Len(t, bot.migratedChats, maxMigratedChats)
+	assert.Len(t, bot.migrationOrder, maxMigratedChats)
+
+	// The oldest migration was forgotten, the newest ones are still known
+	require.NoError(t, bot.chatMigrated(ctx, -maxMigratedChats-1, -1000-maxMigratedChats-1))
+	assert.Equal(t, maxMigratedChats+1, calls)
+	require.NoError(t, bot.chatMigrated(ctx, -1, -1001))
+	assert.Equal(t, maxMigratedChats+2, calls)
+}
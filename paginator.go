@@ -0,0 +1,288 @@
+package gotelegrambot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PaginatorItem is an item listed by a Paginator as a button.
+type PaginatorItem struct {
+	// Text is the text of the button.
+	Text string
+
+	// Data identifies the item in the callback data of the button and is passed
+	// to the item handler. Together with the prefix of the Paginator it must fit
+	// in MaxCallbackDataLength bytes.
+	Data string
+}
+
+// PaginatorSource returns up to limit items of the chat starting at offset,
+// together with the total number of items. The chat ID is zero for messages
+// sent in inline mode.
+type PaginatorSource func(ctx context.Context, chatID ChatID, offset, limit int) (items []PaginatorItem, total int, err error)
+
+// PaginatorPage is a rendered page of a Paginator.
+type PaginatorPage struct {
+	ChatID ChatID
+	Items  []PaginatorItem
+
+	// Number is the 0-based number of the page.
+	Number int
+
+	// Count is the number of pages, at least 1.
+	Count int
+
+	// Total is the number of items.
+	Total int
+}
+
+// PaginatorItemHandler handles a press on an item button. It must answer the
+// callback query.
+type PaginatorItemHandler func(ctx context.Context, query *CallbackQuery, data string) error
+
+// Paginator lists items from a PaginatorSource page by page in an inline
+// keyboard with previous and next buttons. It handles the callback queries of
+// its buttons and edits the message in place when a page is turned.
+type Paginator struct {
+	bot    *Bot
+	prefix string
+	source PaginatorSource
+
+	pageSize  int
+	columns   int
+	text      func(page *PaginatorPage) string
+	parseMode string
+	onItem    PaginatorItemHandler
+	prevText  string
+	nextText  string
+}
+
+// PaginatorOption is a function that configures a Paginator.
+type PaginatorOption func(*Paginator)
+
+// WithPaginatorPageSize sets the number of items per page. It defaults to 5.
+func WithPaginatorPageSize(size int) PaginatorOption {
+	return func(p *Paginator) {
+		p.pageSize = size
+	}
+}
+
+// WithPaginatorColumns sets the number of item buttons per row. It defaults to 1.
+func WithPaginatorColumns(columns int) PaginatorOption {
+	return func(p *Paginator) {
+		p.columns = columns
+	}
+}
+
+// WithPaginatorText sets the function rendering the message text of a page.
+// When a page is turned and the text is unchanged, only the keyboard is edited.
+func WithPaginatorText(text func(page *PaginatorPage) string) PaginatorOption {
+	return func(p *Paginator) {
+		p.text = text
+	}
+}
+
+// WithPaginatorParseMode sets the parse mode of the message text.
+func WithPaginatorParseMode(parseMode string) PaginatorOption {
+	return func(p *Paginator) {
+		p.parseMode = parseMode
+	}
+}
+
+// WithPaginatorItemHandler sets the handler of item buttons. Without it, item
+// presses are only answered.
+func WithPaginatorItemHandler(handler PaginatorItemHandler) PaginatorOption {
+	return func(p *Paginator) {
+		p.onItem = handler
+	}
+}
+
+// WithPaginatorNavText sets the text of the previous and next buttons.
+func WithPaginatorNavText(prev, next string) PaginatorOption {
+	return func(p *Paginator) {
+		p.prevText = prev
+		p.nextText = next
+	}
+}
+
+// NewPaginator creates a Paginator and registers the callback route of its
+// buttons, whose data starts with prefix. The prefix must not be the prefix of
+// another route, for example "orders:".
+func (b *Bot) NewPaginator(prefix string, source PaginatorSource, options ...PaginatorOption) *Paginator {
+	p := &Paginator{
+		bot:      b,
+		prefix:   prefix,
+		source:   source,
+		pageSize: 5,
+		columns:  1,
+		text:     pageNumberText,
+		prevText: "« Prev",
+		nextText: "Next »",
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+	if p.pageSize <= 0 {
+		p.pageSize = 5
+	}
+
+	b.OnCallbackQuery(prefix, p.handleCallback)
+
+	return p
+}
+
+// pageNumberText is the default text of a page.
+func pageNumberText(page *PaginatorPage) string {
+	return fmt.Sprintf("Page %d of %d", page.Number+1, page.Count)
+}
+
+// Send sends the first page to the chat.
+func (p *Paginator) Send(ctx context.Context, chatID ChatID, options ...SendMessageOption) (*Message, error) {
+	text, markup, err := p.Render(ctx, chatID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	options = append([]SendMessageOption{WithReplyMarkup(markup)}, options...)
+	if p.parseMode != "" {
+		options = append([]SendMessageOption{WithParseMode(p.parseMode)}, options...)
+	}
+	return p.bot.SendMessage(ctx, chatID, text, options...)
+}
+
+// Render returns the text and keyboard of a page. Page numbers past the last
+// page render the last page.
+func (p *Paginator) Render(ctx context.Context, chatID ChatID, number int) (string, *InlineKeyboardMarkup, error) {
+	page, err := p.page(ctx, chatID, number)
+	if err != nil {
+		return "", nil, err
+	}
+
+	items := make([]InlineKeyboardButton, len(page.Items))
+	for i, item := range page.Items {
+		items[i] = NewInlineKeyboardButtonCallback(item.Text, p.prefix+"i:"+item.Data)
+	}
+
+	keyboard := NewInlineKeyboardBuilder().Grid(p.columns, items...)
+	if page.Count > 1 {
+		keyboard.
+			AddIf(page.Number > 0, NewInlineKeyboardButtonCallback(p.prevText, p.pageData(page.Number-1))).
+			Add(NewInlineKeyboardButtonCallback(fmt.Sprintf("%d/%d", page.Number+1, page.Count), p.prefix+"n")).
+			AddIf(page.Number < page.Count-1, NewInlineKeyboardButtonCallback(p.nextText, p.pageData(page.Number+1)))
+	}
+
+	markup, err := keyboard.Build()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "invalid paginator keyboard")
+	}
+	return p.text(page), markup, nil
+}
+
+// page loads a page from the source, clamping its number.
+func (p *Paginator) page(ctx context.Context, chatID ChatID, number int) (*PaginatorPage, error) {
+	if number < 0 {
+		number = 0
+	}
+
+	items, total, err := p.source(ctx, chatID, number*p.pageSize, p.pageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load paginator items")
+	}
+
+	count := (total + p.pageSize - 1) / p.pageSize
+	if count == 0 {
+		count = 1
+	}
+	if number >= count {
+		// The list shrank since the page was rendered
+		number = count - 1
+		items, total, err = p.source(ctx, chatID, number*p.pageSize, p.pageSize)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load paginator items")
+		}
+	}
+	if len(items) > p.pageSize {
+		items = items[:p.pageSize]
+	}
+
+	return &PaginatorPage{
+		ChatID: chatID,
+		Items:  items,
+		Number: number,
+		Count:  count,
+		Total:  total,
+	}, nil
+}
+
+// pageData returns the callback data of the button turning to a page.
+func (p *Paginator) pageData(number int) string {
+	return p.prefix + "p:" + strconv.Itoa(number)
+}
+
+func (p *Paginator) handleCallback(ctx context.Context, query *CallbackQuery) error {
+	data := strings.TrimPrefix(query.Data, p.prefix)
+
+	if item, ok := strings.CutPrefix(data, "i:"); ok && p.onItem != nil {
+		return p.onItem(ctx, query, item)
+	}
+
+	if err := p.bot.AnswerCallbackQuery(ctx, query.ID); err != nil {
+		p.bot.debug("Error answering paginator callback: %v", err)
+	}
+
+	number, ok := strings.CutPrefix(data, "p:")
+	if !ok {
+		return nil
+	}
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return nil
+	}
+
+	return p.turn(ctx, query, n)
+}
+
+// turn edits the message of the query to show a page.
+func (p *Paginator) turn(ctx context.Context, query *CallbackQuery, number int) error {
+	var chatID ChatID
+	var messageID int
+	var currentText string
+	if query.Message != nil {
+		chatID = query.Message.ChatID()
+		messageID = query.Message.MessageID
+		currentText = query.Message.Text
+	}
+
+	text, markup, err := p.Render(ctx, chatID, number)
+	if err != nil {
+		return err
+	}
+
+	if query.Message != nil && text == currentText {
+		_, err = p.bot.EditMessageReplyMarkup(ctx, EditMessageReplyMarkupParams{
+			ChatID:          chatID,
+			MessageID:       messageID,
+			InlineMessageID: query.InlineMessageID,
+			ReplyMarkup:     markup,
+		})
+	} else {
+		_, err = p.bot.EditMessageText(ctx,
+			WithChatID(chatID),
+			WithMessageID(messageID),
+			WithInlineMessageID(query.InlineMessageID),
+			WithText(text),
+			WithEditParseMode(p.parseMode),
+			WithEditReplyMarkup(markup))
+	}
+
+	if errors.Is(err, ErrMessageNotModified) {
+		// The same page was requested twice in a row
+		return nil
+	}
+	return errors.Wrap(err, "failed to turn paginator page")
+}
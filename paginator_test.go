@@ -0,0 +1,97 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginator(t *testing.T) {
+	var calls []string
+	var params []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, path.Base(r.URL.Path))
+		params = append(params, body)
+
+		switch path.Base(r.URL.Path) {
+		case "sendMessage", "editMessageText", "editMessageReplyMarkup":
+			w.Write([]byte(`{"ok":true,"result":{"message_id":9,"date":0,"chat":{"id":42,"type":"private"}}}`))
+		default:
+			w.Write([]byte(`{"ok":true,"result":true}`))
+		}
+	}))
+	defer server.Close()
+
+	bot, _ := New("test_token")
+	bot.APIEndpoint = server.URL + "/bottest_token"
+	ctx := context.Background()
+
+	orders := 7
+	source := func(ctx context.Context, chatID ChatID, offset, limit int) ([]PaginatorItem, int, error) {
+		assert.Equal(t, NewChatID(42), chatID)
+		var items []PaginatorItem
+		for i := offset; i < offset+limit && i < orders; i++ {
+			items = append(items, PaginatorItem{Text: "Order " + strconv.Itoa(i), Data: strconv.Itoa(i)})
+		}
+		return items, orders, nil
+	}
+
+	var picked string
+	paginator := bot.NewPaginator("orders:", source,
+		WithPaginatorPageSize(3),
+		WithPaginatorItemHandler(func(ctx context.Context, query *CallbackQuery, data string) error {
+			picked = data
+			return bot.AnswerCallbackQuery(ctx, query.ID)
+		}))
+
+	_, err := paginator.Send(ctx, NewChatID(42))
+	require.NoError(t, err)
+	require.Equal(t, []string{"sendMessage"}, calls)
+	assert.Equal(t, "Page 1 of 3", params[0]["text"])
+	assert.Equal(t, map[string]interface{}{"inline_keyboard": []interface{}{
+		[]interface{}{map[string]interface{}{"text": "Order 0", "callback_data": "orders:i:0"}},
+		[]interface{}{map[string]interface{}{"text": "Order 1", "callback_data": "orders:i:1"}},
+		[]interface{}{map[string]interface{}{"text": "Order 2", "callback_data": "orders:i:2"}},
+		[]interface{}{
+			map[string]interface{}{"text": "1/3", "callback_data": "orders:n"},
+			map[string]interface{}{"text": "Next »", "callback_data": "orders:p:1"},
+		},
+	}}, params[0]["reply_markup"])
+
+	press := func(data, text string) {
+		calls, params = nil, nil
+		update := &Update{CallbackQuery: &CallbackQuery{
+			ID:      "q",
+			Message: &Message{MessageID: 9, Chat: &Chat{ID: 42}, Text: text},
+			Data:    data,
+		}}
+		require.NoError(t, bot.handleUpdate(ctx, update, nil))
+	}
+
+	press("orders:p:2", "Page 1 of 3")
+	assert.Equal(t, []string{"answerCallbackQuery", "editMessageText"}, calls)
+	assert.Equal(t, "Page 3 of 3", params[1]["text"])
+	assert.Equal(t, float64(9), params[1]["message_id"])
+
+	// Turning to a page past the end shows the last one
+	orders = 4
+	press("orders:p:2", "Page 1 of 3")
+	assert.Equal(t, "Page 2 of 2", params[1]["text"])
+
+	paginator.text = func(*PaginatorPage) string { return "Orders" }
+	press("orders:p:0", "Orders")
+	assert.Equal(t, []string{"answerCallbackQuery", "editMessageReplyMarkup"}, calls)
+
+	press("orders:i:3", "Orders")
+	assert.Equal(t, []string{"answerCallbackQuery"}, calls)
+	assert.Equal(t, "3", picked)
+}
@@ -0,0 +1,159 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate go run ./cmd/botapigen -spec api/botapi.json
+
+// Params is implemented by the generated XxxParams struct of each Bot API
+// method, which holds its parameters with the JSON names of the API. R is the
+// result of the method as it documents it: *Message for SendMessageParams,
+// []ChatMember for GetChatAdministratorsParams and bool for methods that only
+// return True. Optional parameters whose zero value means something else than
+// leaving them out, such as SendPollParams.IsAnonymous, are pointers; use Ptr
+// to set them.
+type Params[R any] interface {
+	// Method returns the name of the Bot API method.
+	Method() string
+
+	call(ctx context.Context, b *Bot) (R, error)
+}
+
+// Do calls the Bot API method of params and returns its typed result, inferred
+// from the params type:
+//
+//	message, err := gotelegrambot.Do(ctx, bot, gotelegrambot.SendMessageParams{ChatID: chatID, Text: "Hi"})
+//
+// Methods like EditMessageText returning a Message, or True for inline
+// messages, return a nil *Message in the latter case.
+//
+// The named methods, such as SendMessage, are shortcuts for Do. Do sends the
+// params as they are, without the defaults the named methods add, like the
+// allowed updates needed by registered handlers.
+func Do[R any](ctx context.Context, b *Bot, params Params[R]) (R, error) {
+	var zero R
+	if params == nil {
+		return zero, errors.New("params are required")
+	}
+
+	result, err := params.call(ctx, b)
+	if err != nil {
+		return zero, err
+	}
+
+	if file, ok := interface{}(result).(*File); ok {
+		b.setFileURL(file)
+	}
+	return result, nil
+}
+
+// Ptr returns a pointer to v, to set the pointer fields of params structs.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// makeParamsRequest sends a call whose params are a params struct. The
+// fields listed in files are uploaded when they hold a file:// path.
+func (b *Bot) makeParamsRequest(ctx context.Context, method string, params interface{}, files []string, result interface{}) error {
+	values, err := paramsMap(params)
+	if err != nil {
+		return err
+	}
+
+	var uploads map[string]string
+	for _, field := range files {
+		value, ok := values[field]
+		if !ok {
+			continue
+		}
+
+		path, isPath := value.(string)
+		switch {
+		case isPath && IsInputFile(path):
+			if uploads == nil {
+				uploads = make(map[string]string)
+			}
+			uploads[field] = path[len("file://"):]
+			delete(values, field)
+		case IsInputFile(value):
+			return errors.Errorf("%s uploads are only supported from a file:// path", field)
+		}
+	}
+
+	if uploads != nil {
+		return b.makeMultipartRequest(ctx, method, values, uploads, result)
+	}
+	return b.makeRequest(ctx, method, values, result)
+}
+
+// paramsMap converts a params struct to request params following its json
+// tags: fields tagged omitempty are left out when zero, others always sent.
+// ChatID fields are sent as their ID or username and must be valid.
+func paramsMap(params interface{}) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	v := reflect.ValueOf(params)
+	if !v.IsValid() {
+		return values, nil
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return values, nil
+		}
+		v = v.Elem()
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		value := v.Field(i)
+		if value.Kind() == reflect.Interface && !value.IsNil() && value.Elem().Kind() == reflect.Ptr && value.Elem().IsNil() {
+			// A nil *InlineKeyboardMarkup set as a ReplyMarkup
+			value = reflect.Zero(value.Type())
+		}
+		if options == "omitempty" && value.IsZero() {
+			continue
+		}
+		if chatID, ok := value.Interface().(ChatID); ok {
+			if err := chatID.Validate(); err != nil {
+				return nil, errors.Wrapf(err, "invalid %s", name)
+			}
+			values[name] = chatID.value()
+			continue
+		}
+		values[name] = value.Interface()
+	}
+	return values, nil
+}
+
+// messageOrTrue decodes the result of methods returning the edited Message,
+// or True for inline messages.
+type messageOrTrue struct {
+	Message *Message
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *messageOrTrue) UnmarshalJSON(data []byte) error {
+	if string(data) == "true" {
+		m.Message = nil
+		return nil
+	}
+
+	var message Message
+	if err := json.Unmarshal(data, &message); err != nil {
+		return err
+	}
+	m.Message = &message
+	return nil
+}
@@ -0,0 +1,214 @@
+package gotelegrambot
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsMap(t *testing.T) {
+	params, err := paramsMap(SendLocationParams{
+		ChatID:    NewChatID(42),
+		Latitude:  0,
+		Longitude: 13.4,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"chat_id":   int64(42),
+		"latitude":  float64(0),
+		"longitude": 13.4,
+	}, params)
+
+	params, err = paramsMap(EditMessageTextParams{InlineMessageID: "1", Text: "hi"})
+	require.NoError(t, err)
+	assert.NotContains(t, params, "chat_id")
+
+	params, err = paramsMap(SendDiceParams{ChatID: NewChatUsername("channel")})
+	require.NoError(t, err)
+	assert.Equal(t, "@channel", params["chat_id"])
+
+	var keyboard *InlineKeyboardMarkup
+	params, err = paramsMap(SendDiceParams{ChatID: NewChatID(42), ReplyMarkup: keyboard})
+	require.NoError(t, err)
+	assert.NotContains(t, params, "reply_markup")
+
+	params, err = paramsMap(SendDiceParams{ChatID: NewChatID(42), ReplyMarkup: NewReplyKeyboardRemove(false)})
+	require.NoError(t, err)
+	assert.Equal(t, &ReplyKeyboardRemove{RemoveKeyboard: true}, params["reply_markup"])
+
+	_, err = paramsMap(SendDiceParams{})
+	assert.EqualError(t, err, "invalid chat_id: chat ID is required")
+
+	params, err = paramsMap(nil)
+	require.NoError(t, err)
+	assert.Empty(t, params)
+}
+
+func TestParamsRequest(t *testing.T) {
+	var contentType string
+	var document []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		switch r.URL.Path {
+		case "/sendDocument":
+			file, _, err := r.FormFile("document")
+			if err == nil {
+				document, _ = io.ReadAll(file)
+			}
+			w.Write([]byte(`{"ok":true,"result":{"message_id":3,"date":0}}`))
+		case "/editMessageReplyMarkup":
+			w.Write([]byte(`{"ok":true,"result":true}`))
+		}
+	}))
+	defer server.Close()
+
+	bot, _ := New("test_token")
+	bot.APIEndpoint = server.URL
+
+	path := filepath.Join(t.TempDir(), "report.txt")
+	require.NoError(t, os.WriteFile(path, []byte("report"), 0o644))
+
+	message, err := bot.SendDocument(context.Background(), SendDocumentParams{
+		ChatID:   NewChatID(42),
+		Document: "file://" + path,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, message.MessageID)
+	assert.Contains(t, contentType, "multipart/form-data")
+	assert.Equal(t, "report", string(document))
+
+	message, err = bot.EditMessageReplyMarkup(context.Background(), EditMessageReplyMarkupParams{
+		InlineMessageID: "inline-1",
+	})
+	require.NoError(t, err)
+	assert.Nil(t, message)
+	assert.Equal(t, "application/json", contentType)
+}
+
+func TestDo(t *testing.T) {
+	var calls []string
+	var params []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		calls = append(calls, r.URL.Path)
+		params = append(params, body)
+
+		switch path.Base(r.URL.Path) {
+		case "sendPoll":
+			w.Write([]byte(`{"ok":true,"result":{"message_id":5,"date":0}}`))
+		case "getChatMemberCount":
+			w.Write([]byte(`{"ok":true,"result":12}`))
+		case "getFile":
+			w.Write([]byte(`{"ok":true,"result":{"file_id":"f","file_unique_id":"u","file_path":"docs/a.txt"}}`))
+		default:
+			w.Write([]byte(`{"ok":true,"result":true}`))
+		}
+	}))
+	defer server.Close()
+
+	bot, _ := New("test_token")
+	bot.APIEndpoint = server.URL + "/bottest_token"
+	ctx := context.Background()
+
+	message, err := Do(ctx, bot, SendPollParams{
+		ChatID:          NewChatID(42),
+		Question:        "Pick one",
+		Options:         []InputPollOption{{Text: "a"}, {Text: "b"}},
+		IsAnonymous:     Ptr(false),
+		CorrectOptionID: Ptr(0),
+		Type:            "quiz",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5, message.MessageID)
+	assert.Equal(t, "/bottest_token/sendPoll", calls[0])
+	assert.Equal(t, false, params[0]["is_anonymous"])
+	assert.Equal(t, float64(0), params[0]["correct_option_id"])
+	assert.NotContains(t, params[0], "allows_multiple_answers")
+
+	count, err := Do(ctx, bot, GetChatMemberCountParams{ChatID: NewChatUsername("@channel")})
+	require.NoError(t, err)
+	assert.Equal(t, 12, count)
+
+	deleted, err := Do(ctx, bot, DeleteMessageParams{ChatID: NewChatID(42), MessageID: 7})
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	file, err := Do(ctx, bot, GetFileParams{FileID: "f"})
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/file/bottest_token/docs/a.txt", file.URL)
+
+	deleted, err = Do(ctx, bot, DeleteMessageParams{MessageID: 7})
+	assert.EqualError(t, err, "invalid chat_id: chat ID is required")
+	assert.False(t, deleted)
+	assert.Len(t, calls, 4)
+
+	_, err = Do[*Message](ctx, bot, nil)
+	assert.Error(t, err)
+}
+
+func TestMakeRequest(t *testing.T) {
+	var method string
+	var params map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = path.Base(r.URL.Path)
+		params = nil
+		json.NewDecoder(r.Body).Decode(&params)
+		w.Write([]byte(`{"ok":true,"result":{"message_id":5,"date":0}}`))
+	}))
+	defer server.Close()
+
+	bot, _ := New("test_token")
+	bot.APIEndpoint = server.URL + "/bottest_token"
+	ctx := context.Background()
+
+	result, err := bot.MakeRequest(ctx, "sendMessage", map[string]interface{}{"chat_id": 42, "text": "Hi"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"message_id":5,"date":0}`, string(result))
+	assert.Equal(t, "sendMessage", method)
+	assert.Equal(t, map[string]interface{}{"chat_id": float64(42), "text": "Hi"}, params)
+
+	_, err = bot.MakeRequest(ctx, "sendMessage", SendMessageParams{ChatID: NewChatUsername("channel"), Text: "Hi"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"chat_id": "@channel", "text": "Hi"}, params)
+
+	_, err = bot.MakeRequest(ctx, "getMe", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "getMe", method)
+
+	_, err = bot.MakeRequest(ctx, "sendMessage", []string{"not", "an", "object"})
+	assert.Error(t, err)
+}
+
+func TestOptionsFillParams(t *testing.T) {
+	var params SendMessageParams
+	for _, opt := range []SendMessageOption{
+		WithParseMode(ParseModeHTML),
+		WithDisableWebPagePreview(true),
+		WithAllowSendingWithoutReply(true),
+		WithReplyToMessageID(9),
+	} {
+		opt(&params)
+	}
+	dropEmptyReply(&params.ReplyParameters)
+
+	assert.Equal(t, ParseModeHTML, params.ParseMode)
+	assert.Equal(t, &LinkPreviewOptions{IsDisabled: true}, params.LinkPreviewOptions)
+	assert.Equal(t, &ReplyParameters{MessageID: 9, AllowSendingWithoutReply: true}, params.ReplyParameters)
+
+	params = SendMessageParams{}
+	WithAllowSendingWithoutReply(true)(&params)
+	dropEmptyReply(&params.ReplyParameters)
+	assert.Nil(t, params.ReplyParameters)
+
+	assert.Equal(t, "sendMessage", params.Method())
+}
@@ -24,6 +24,8 @@ func (b *Bot) StartPolling(ctx context.Context, handler UpdateHandler, options .
 		opt(&opts)
 	}
 
+	opts.AllowedUpdates = b.allowedUpdates(opts.AllowedUpdates)
+
 	go b.startPollingLoop(ctx, opts)
 	return nil
 }
@@ -100,9 +102,14 @@ func (b *Bot) startPollingLoop(ctx context.Context, opts pollingOptions) {
 			b.debug("Polling loop stopped by shutdown")
 			return
 		default:
-			updates, err := b.getUpdates(ctx, offset, opts.Limit, opts.Timeout, opts.AllowedUpdates)
+			updates, err := b.GetUpdates(ctx, GetUpdatesParams{
+				Offset:         offset,
+				Limit:          &opts.Limit,
+				Timeout:        opts.Timeout,
+				AllowedUpdates: opts.AllowedUpdates,
+			})
 			if err != nil {
-				b.debug("Error getting updates: %v", err)
+				b.log(LogLevelWarn, "getting updates failed", "error", err)
 				time.Sleep(opts.PollInterval)
 				continue
 			}
@@ -115,7 +122,7 @@ func (b *Bot) startPollingLoop(ctx context.Context, opts pollingOptions) {
 				go func(update Update) {
 					err := b.processUpdate(ctx, &update)
 					if err != nil {
-						b.debug("Error processing update: %v", err)
+						b.log(LogLevelError, "processing update failed", "update_id", update.UpdateID, "error", err)
 					}
 				}(update)
 			}
@@ -127,29 +134,55 @@ func (b *Bot) startPollingLoop(ctx context.Context, opts pollingOptions) {
 	}
 }
 
-// getUpdates gets updates from the Telegram API.
-func (b *Bot) getUpdates(ctx context.Context, offset, limit, timeout int, allowedUpdates []string) ([]Update, error) {
-	params := map[string]interface{}{
-		"offset":  offset,
-		"limit":   limit,
-		"timeout": timeout,
-	}
-	
-	if len(allowedUpdates) > 0 {
-		params["allowed_updates"] = allowedUpdates
-	}
-	
-	// TODO: Implement the actual API call
-	// This is a placeholder
-	return []Update{}, nil
-}
-
 // processUpdate processes a single update.
 func (b *Bot) processUpdate(ctx context.Context, update *Update) error {
 	b.mu.RLock()
 	handler := b.updateHandler
 	b.mu.RUnlock()
 	
+	return b.handleUpdate(ctx, update, handler)
+}
+
+// handleUpdate runs the registered hooks and then the handler for a single update.
+func (b *Bot) handleUpdate(ctx context.Context, update *Update, handler UpdateHandler) error {
+	updateType := UpdateType(update)
+	b.log(LogLevelDebug, "update received", "update_id", update.UpdateID, "type", updateType)
+	
+	metrics := b.instruments()
+	metrics.UpdateReceived(updateType)
+	metrics.HandlerStarted(updateType)
+	start := time.Now()
+	
+	ctx, span := b.startSpan(ctx, SpanUpdate, Attr(AttrUpdateID, update.UpdateID), Attr(AttrUpdateType, updateType))
+	defer span.End()
+	
+	err := b.runUpdateHandlers(ctx, update, handler)
+	metrics.HandlerDone(updateType, time.Since(start), err)
+	if err != nil {
+		span.RecordError(err)
+	}
+	
+	return err
+}
+
+// runUpdateHandlers runs the registered hooks and then the handler.
+func (b *Bot) runUpdateHandlers(ctx context.Context, update *Update, handler UpdateHandler) error {
+	if err := b.runChatMigrationHooks(ctx, update); err != nil {
+		return err
+	}
+	
+	if err := b.runChatMemberHooks(ctx, update); err != nil {
+		return err
+	}
+	
+	if err := b.runJoinRequestHooks(ctx, update); err != nil {
+		return err
+	}
+	
+	if err := b.runCallbackRoutes(ctx, update); err != nil {
+		return err
+	}
+	
 	if handler != nil {
 		return handler(ctx, update)
 	}
@@ -0,0 +1,225 @@
+package gotelegrambot
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultDurationBuckets are the histogram buckets, in seconds, used by PrometheusMetrics.
+var DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// PrometheusMetrics is a Metrics implementation that exposes its measurements
+// in the Prometheus text exposition format. It is also an http.Handler serving them.
+type PrometheusMetrics struct {
+	mu               sync.Mutex
+	apiRequests      map[string]float64
+	apiErrors        map[string]float64
+	apiDuration      map[string]*histogram
+	apiRetries       map[string]float64
+	rateLimitWaits   map[string]float64
+	rateLimitSeconds map[string]float64
+	updates          map[string]float64
+	handlerDuration  map[string]*histogram
+	handlerFailures  map[string]float64
+	inFlight         float64
+}
+
+// NewPrometheusMetrics creates an empty PrometheusMetrics.
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return &PrometheusMetrics{
+		apiRequests:      make(map[string]float64),
+		apiErrors:        make(map[string]float64),
+		apiDuration:      make(map[string]*histogram),
+		apiRetries:       make(map[string]float64),
+		rateLimitWaits:   make(map[string]float64),
+		rateLimitSeconds: make(map[string]float64),
+		updates:          make(map[string]float64),
+		handlerDuration:  make(map[string]*histogram),
+		handlerFailures:  make(map[string]float64),
+	}
+}
+
+// APIRequest records a completed API call.
+func (m *PrometheusMetrics) APIRequest(method string, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.apiRequests[method]++
+	if err != nil {
+		m.apiErrors[method]++
+	}
+	observe(m.apiDuration, method, duration)
+}
+
+// APIRetry records a retry of an API call.
+func (m *PrometheusMetrics) APIRetry(method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.apiRetries[method]++
+}
+
+// RateLimitWait records a wait requested by Telegram.
+func (m *PrometheusMetrics) RateLimitWait(method string, wait time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rateLimitWaits[method]++
+	m.rateLimitSeconds[method] += wait.Seconds()
+}
+
+// UpdateReceived records an update.
+func (m *PrometheusMetrics) UpdateReceived(updateType string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.updates[updateType]++
+}
+
+// HandlerStarted records the start of the handling of an update.
+func (m *PrometheusMetrics) HandlerStarted(updateType string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inFlight++
+}
+
+// HandlerDone records the end of the handling of an update.
+func (m *PrometheusMetrics) HandlerDone(updateType string, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inFlight--
+	if err != nil {
+		m.handlerFailures[updateType]++
+	}
+	observe(m.handlerDuration, updateType, duration)
+}
+
+// WriteTo writes all metrics in the Prometheus text exposition format.
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cw := &countingWriter{w: w}
+
+	writeCounter(cw, "telegram_api_requests_total", "Number of Bot API calls.", "method", m.apiRequests)
+	writeCounter(cw, "telegram_api_errors_total", "Number of failed Bot API calls.", "method", m.apiErrors)
+	writeHistogram(cw, "telegram_api_request_duration_seconds", "Duration of Bot API calls, including retries.", "method", m.apiDuration)
+	writeCounter(cw, "telegram_api_retries_total", "Number of retried Bot API calls.", "method", m.apiRetries)
+	writeCounter(cw, "telegram_api_rate_limit_waits_total", "Number of waits requested with retry_after.", "method", m.rateLimitWaits)
+	writeCounter(cw, "telegram_api_rate_limit_wait_seconds_total", "Time spent waiting for rate limits.", "method", m.rateLimitSeconds)
+	writeCounter(cw, "telegram_updates_received_total", "Number of updates received.", "type", m.updates)
+	writeHistogram(cw, "telegram_handler_duration_seconds", "Duration of update handling.", "type", m.handlerDuration)
+	writeCounter(cw, "telegram_handler_failures_total", "Number of updates whose handling failed.", "type", m.handlerFailures)
+
+	fmt.Fprintf(cw, "# HELP telegram_handlers_in_flight Number of updates being handled.\n")
+	fmt.Fprintf(cw, "# TYPE telegram_handlers_in_flight gauge\n")
+	fmt.Fprintf(cw, "telegram_handlers_in_flight %s\n", formatFloat(m.inFlight))
+
+	return cw.n, cw.err
+}
+
+// ServeHTTP serves the metrics, so that PrometheusMetrics can be mounted on /metrics.
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// histogram is a cumulative histogram over DefaultDurationBuckets.
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func observe(histograms map[string]*histogram, label string, duration time.Duration) {
+	h, ok := histograms[label]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(DefaultDurationBuckets))}
+		histograms[label] = h
+	}
+
+	seconds := duration.Seconds()
+	for i, bound := range DefaultDurationBuckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+func writeCounter(w io.Writer, name, help, label string, values map[string]float64) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s counter\n", name)
+	for _, key := range sortedKeys(values) {
+		fmt.Fprintf(w, "%s{%s=%s} %s\n", name, label, quoteLabel(key), formatFloat(values[key]))
+	}
+}
+
+func writeHistogram(w io.Writer, name, help, label string, histograms map[string]*histogram) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s histogram\n", name)
+
+	keys := make([]string, 0, len(histograms))
+	for key := range histograms {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		h := histograms[key]
+		value := quoteLabel(key)
+		for i, bound := range DefaultDurationBuckets {
+			fmt.Fprintf(w, "%s_bucket{%s=%s,le=\"%s\"} %d\n", name, label, value, formatFloat(bound), h.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket{%s=%s,le=\"+Inf\"} %d\n", name, label, value, h.count)
+		fmt.Fprintf(w, "%s_sum{%s=%s} %s\n", name, label, value, formatFloat(h.sum))
+		fmt.Fprintf(w, "%s_count{%s=%s} %d\n", name, label, value, h.count)
+	}
+}
+
+func sortedKeys(values map[string]float64) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// quoteLabel quotes a label value with the escaping of the exposition format.
+func quoteLabel(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// countingWriter counts the bytes written and keeps the first error.
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	cw.err = err
+	return n, err
+}
@@ -0,0 +1,60 @@
+package gotelegrambot
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Write([]byte(`{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 1","parameters":{"retry_after":1}}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0}}`))
+	}))
+	defer server.Close()
+
+	metrics := NewPrometheusMetrics()
+	bot, _ := New("test_token", WithMetrics(metrics), WithRetryCount(1))
+	bot.APIEndpoint = server.URL
+
+	var waits []time.Duration
+	bot.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	_, err := bot.SendMessage(context.Background(), NewChatID(1), "hello")
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{time.Second}, waits)
+
+	update := &Update{UpdateID: 1, Message: &Message{Text: "hi"}}
+	err = bot.handleUpdate(context.Background(), update, func(ctx context.Context, update *Update) error {
+		return errors.New("handler failed")
+	})
+	require.Error(t, err)
+
+	var buf bytes.Buffer
+	_, err = metrics.WriteTo(&buf)
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, `telegram_api_requests_total{method="sendMessage"} 1`)
+	assert.Contains(t, out, `telegram_api_retries_total{method="sendMessage"} 1`)
+	assert.Contains(t, out, `telegram_api_rate_limit_waits_total{method="sendMessage"} 1`)
+	assert.Contains(t, out, `telegram_api_request_duration_seconds_count{method="sendMessage"} 1`)
+	assert.Contains(t, out, `telegram_updates_received_total{type="message"} 1`)
+	assert.Contains(t, out, `telegram_handler_failures_total{type="message"} 1`)
+	assert.Contains(t, out, "telegram_handlers_in_flight 0")
+}
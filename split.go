@@ -0,0 +1,413 @@
+package gotelegrambot
+
+import (
+	"context"
+	"html"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Telegram limits on text length, counted in UTF-16 code units after entity parsing.
+const (
+	MaxMessageLength = 4096
+	MaxCaptionLength = 1024
+)
+
+// TextChunk is one piece of a split text together with the entities that fall inside it.
+type TextChunk struct {
+	Text     string
+	Entities []MessageEntity
+}
+
+// SendLongMessage sends a text message of any length, splitting it into several
+// messages when it exceeds MaxMessageLength. Texts formatted with entities or with
+// the HTML parse mode are split without breaking entities or tags. The reply markup
+// is attached only to the last message and the reply only to the first one.
+func (b *Bot) SendLongMessage(ctx context.Context, chatID ChatID, text string, options ...SendMessageOption) ([]*Message, error) {
+	var params SendMessageParams
+	for _, opt := range options {
+		opt(&params)
+	}
+
+	chunks, err := SplitFormatted(text, params.Entities, params.ParseMode, MaxMessageLength)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*Message, 0, len(chunks))
+	for i, chunk := range chunks {
+		chunkOptions := append(options[:len(options):len(options)], WithEntities(chunk.Entities))
+		if i > 0 {
+			chunkOptions = append(chunkOptions, WithReplyToMessageID(0))
+		}
+		if i < len(chunks)-1 {
+			chunkOptions = append(chunkOptions, WithReplyMarkup(nil))
+		}
+
+		message, err := b.SendMessage(ctx, chatID, chunk.Text, chunkOptions...)
+		if err != nil {
+			return messages, errors.Wrapf(err, "failed to send part %d of %d", i+1, len(chunks))
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// SplitFormatted splits a text formatted either with entities or with the given
+// parse mode into chunks of at most limit characters. Markdown texts cannot be
+// split safely, so an error is returned when they exceed the limit.
+func SplitFormatted(text string, entities []MessageEntity, parseMode string, limit int) ([]TextChunk, error) {
+	return splitFormatted(text, entities, parseMode, func(int) int { return limit })
+}
+
+// SplitCaption splits a caption into the part that fits MaxCaptionLength and the
+// overflow, which is split at MaxMessageLength so it can be sent as text messages
+// following the media.
+func SplitCaption(caption string, entities []MessageEntity, parseMode string) (TextChunk, []TextChunk, error) {
+	chunks, err := splitFormatted(caption, entities, parseMode, func(i int) int {
+		if i == 0 {
+			return MaxCaptionLength
+		}
+		return MaxMessageLength
+	})
+	if err != nil {
+		return TextChunk{}, nil, err
+	}
+
+	return chunks[0], chunks[1:], nil
+}
+
+func splitFormatted(text string, entities []MessageEntity, parseMode string, limit func(int) int) ([]TextChunk, error) {
+	switch {
+	case parseMode == "":
+		return splitText(text, entities, limit), nil
+	case strings.EqualFold(parseMode, ParseModeHTML):
+		parts := splitHTML(text, limit)
+		chunks := make([]TextChunk, len(parts))
+		for i, part := range parts {
+			chunks[i] = TextChunk{Text: part}
+		}
+		return chunks, nil
+	default:
+		if UTF16Len(text) > limit(0) {
+			return nil, errors.Errorf("text exceeds %d characters and cannot be split in %s parse mode", limit(0), parseMode)
+		}
+		return []TextChunk{{Text: text}}, nil
+	}
+}
+
+// SplitText splits a plain text and its entities into chunks of at most limit
+// UTF-16 code units. Entities crossing a cut are split between the chunks.
+func SplitText(text string, entities []MessageEntity, limit int) []TextChunk {
+	return splitText(text, entities, func(int) int { return limit })
+}
+
+func splitText(text string, entities []MessageEntity, limit func(int) int) []TextChunk {
+	units := utf16.Encode([]rune(text))
+
+	var atomic []textRange
+	for _, entity := range entities {
+		if isAtomicEntity(entity.Type) {
+			start, end := clampEntity(entity, len(units))
+			atomic = append(atomic, textRange{start, end})
+		}
+	}
+
+	ranges := splitRanges(units, atomic, limit)
+	chunks := make([]TextChunk, 0, len(ranges))
+	for _, r := range ranges {
+		chunk := TextChunk{Text: string(utf16.Decode(units[r.start:r.end]))}
+		for _, entity := range entities {
+			start, end := clampEntity(entity, len(units))
+			if start < r.start {
+				start = r.start
+			}
+			if end > r.end {
+				end = r.end
+			}
+			if end <= start {
+				continue
+			}
+			entity.Offset = start - r.start
+			entity.Length = end - start
+			chunk.Entities = append(chunk.Entities, entity)
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// isAtomicEntity reports whether cutting inside an entity of the given type would
+// change its meaning, as opposed to just splitting its formatting.
+func isAtomicEntity(entityType string) bool {
+	switch entityType {
+	case EntityTypeMention, EntityTypeHashtag, EntityTypeCashtag, EntityTypeBotCommand,
+		EntityTypeURL, EntityTypeEmail, EntityTypePhoneNumber, EntityTypeCode,
+		EntityTypeTextLink, EntityTypeTextMention, EntityTypeCustomEmoji:
+		return true
+	}
+	return false
+}
+
+// SplitHTML splits a text formatted with the HTML parse mode into chunks of at
+// most limit visible characters. Tags open at a cut are closed at the end of the
+// chunk and reopened at the start of the next one.
+func SplitHTML(text string, limit int) []string {
+	return splitHTML(text, func(int) int { return limit })
+}
+
+// htmlAtom is either a tag or a single visible character of an HTML text.
+type htmlAtom struct {
+	raw     string
+	tag     string
+	closing bool
+	text    string
+}
+
+func splitHTML(text string, limit func(int) int) []string {
+	atoms := parseHTMLAtoms(text)
+
+	// Lay out the visible text and remember where each atom sits in it
+	var units []uint16
+	var atomic []textRange
+	positions := make([]int, len(atoms))
+	opened := map[string][]int{}
+	preDepth := 0
+	for i, atom := range atoms {
+		positions[i] = len(units)
+		if atom.tag == "" {
+			units = append(units, utf16.Encode([]rune(atom.text))...)
+			continue
+		}
+
+		switch atom.tag {
+		case "pre":
+			if atom.closing {
+				preDepth--
+			} else {
+				preDepth++
+			}
+		case "a", "code", "tg-emoji":
+			if atom.tag == "code" && preDepth > 0 {
+				break
+			}
+			if !atom.closing {
+				opened[atom.tag] = append(opened[atom.tag], len(units))
+			} else if n := len(opened[atom.tag]); n > 0 {
+				atomic = append(atomic, textRange{opened[atom.tag][n-1], len(units)})
+				opened[atom.tag] = opened[atom.tag][:n-1]
+			}
+		}
+	}
+
+	ranges := splitRanges(units, atomic, limit)
+
+	var chunks []string
+	var sb strings.Builder
+	var stack, pending []htmlAtom
+
+	apply := func(atom htmlAtom) {
+		sb.WriteString(atom.raw)
+		if !atom.closing {
+			stack = append(stack, atom)
+			return
+		}
+		for k := len(stack) - 1; k >= 0; k-- {
+			if stack[k].tag == atom.tag {
+				stack = append(stack[:k], stack[k+1:]...)
+				break
+			}
+		}
+	}
+	closeAll := func() {
+		for k := len(stack) - 1; k >= 0; k-- {
+			sb.WriteString("</" + stack[k].tag + ">")
+		}
+		chunks = append(chunks, sb.String())
+		sb.Reset()
+	}
+
+	current := 0
+	for i, atom := range atoms {
+		if atom.tag != "" {
+			pending = append(pending, atom)
+			continue
+		}
+
+		p := positions[i]
+		for current+1 < len(ranges) && p >= ranges[current+1].start {
+			// Closing tags right after the cut still belong to the finished chunk
+			for len(pending) > 0 && pending[0].closing {
+				apply(pending[0])
+				pending = pending[1:]
+			}
+			closeAll()
+			for _, open := range stack {
+				sb.WriteString(open.raw)
+			}
+			current++
+		}
+
+		// Whitespace dropped at a cut
+		if p < ranges[current].start || p >= ranges[current].end {
+			continue
+		}
+
+		for _, tag := range pending {
+			apply(tag)
+		}
+		pending = nil
+		sb.WriteString(atom.raw)
+	}
+
+	for _, tag := range pending {
+		apply(tag)
+	}
+	closeAll()
+
+	return chunks
+}
+
+// parseHTMLAtoms breaks an HTML text into tags and visible characters.
+func parseHTMLAtoms(s string) []htmlAtom {
+	var atoms []htmlAtom
+	for i := 0; i < len(s); {
+		if s[i] == '<' {
+			if j := strings.IndexByte(s[i:], '>'); j > 1 {
+				raw := s[i : i+j+1]
+				body := strings.TrimPrefix(raw[1:len(raw)-1], "/")
+				name := ""
+				if fields := strings.Fields(body); len(fields) > 0 {
+					name = strings.ToLower(fields[0])
+				}
+				atoms = append(atoms, htmlAtom{raw: raw, tag: name, closing: raw[1] == '/'})
+				i += j + 1
+				continue
+			}
+		}
+
+		if s[i] == '&' {
+			if j := strings.IndexByte(s[i:], ';'); j > 1 && j <= 10 {
+				raw := s[i : i+j+1]
+				if decoded := html.UnescapeString(raw); decoded != raw {
+					atoms = append(atoms, htmlAtom{raw: raw, text: decoded})
+					i += j + 1
+					continue
+				}
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		atoms = append(atoms, htmlAtom{raw: s[i : i+size], text: string(r)})
+		i += size
+	}
+	return atoms
+}
+
+// textRange is a half-open range of UTF-16 code units.
+type textRange struct {
+	start, end int
+}
+
+// splitRanges cuts units into ranges, the i-th of which is at most limit(i) units
+// long. Cuts prefer paragraph, line, sentence and word boundaries, in that order,
+// and avoid falling inside the atomic ranges. Whitespace around a cut is dropped.
+func splitRanges(units []uint16, atomic []textRange, limit func(int) int) []textRange {
+	if len(units) == 0 {
+		return []textRange{{0, 0}}
+	}
+
+	var ranges []textRange
+	start := 0
+	for start < len(units) {
+		max := limit(len(ranges))
+		if max < 1 {
+			max = 1
+		}
+
+		end := start + max
+		if end >= len(units) {
+			ranges = append(ranges, textRange{start, len(units)})
+			break
+		}
+
+		cut := findCut(units, start, end, atomic)
+		trimmed := cut
+		for trimmed > start && isSpaceUnit(units[trimmed-1]) {
+			trimmed--
+		}
+		if trimmed > start {
+			ranges = append(ranges, textRange{start, trimmed})
+		}
+
+		start = cut
+		for start < len(units) && isSpaceUnit(units[start]) {
+			start++
+		}
+	}
+
+	return ranges
+}
+
+// findCut returns the position at which to end a chunk starting at start so that
+// it does not exceed end.
+func findCut(units []uint16, start, end int, atomic []textRange) int {
+	allowed := func(i int) bool {
+		for _, r := range atomic {
+			if r.start < i && i < r.end {
+				return false
+			}
+		}
+		return true
+	}
+
+	boundaries := []func(i int) bool{
+		// Paragraph
+		func(i int) bool { return units[i] == '\n' && units[i-1] == '\n' },
+		// Line
+		func(i int) bool { return units[i] == '\n' },
+		// Sentence
+		func(i int) bool {
+			return isSpaceUnit(units[i]) && (units[i-1] == '.' || units[i-1] == '!' || units[i-1] == '?')
+		},
+		// Word
+		func(i int) bool { return isSpaceUnit(units[i]) },
+	}
+
+	// Only accept a boundary in the second half of the window so chunks stay reasonably full
+	half := start + (end-start)/2
+	for _, boundary := range boundaries {
+		for i := end; i > half; i-- {
+			if boundary(i) && allowed(i) {
+				return i
+			}
+		}
+	}
+	for i := half; i > start; i-- {
+		if isSpaceUnit(units[i]) && allowed(i) {
+			return i
+		}
+	}
+
+	// No boundary: cut hard, but not inside a surrogate pair or an atomic range
+	cut := end
+	for _, r := range atomic {
+		if r.start < cut && cut < r.end && r.start > start {
+			cut = r.start
+		}
+	}
+	if utf16.IsSurrogate(rune(units[cut])) && units[cut] >= 0xDC00 && cut-1 > start {
+		cut--
+	}
+	return cut
+}
+
+// isSpaceUnit reports whether the UTF-16 unit is whitespace a chunk can be cut at.
+func isSpaceUnit(u uint16) bool {
+	return u == ' ' || u == '\n' || u == '\t' || u == '\r'
+}
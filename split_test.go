@@ -0,0 +1,138 @@
+package gotelegrambot_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/KazeDevID/gotelegrambot/telegramtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitText(t *testing.T) {
+	// Short texts are returned untouched
+	chunks := gotelegrambot.SplitText("hello", []gotelegrambot.MessageEntity{{Type: gotelegrambot.EntityTypeBold, Offset: 0, Length: 5}}, 10)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "hello", chunks[0].Text)
+
+	// Paragraph boundaries win over word boundaries
+	text := "First paragraph here.\n\nSecond one is longer than that."
+	chunks = gotelegrambot.SplitText(text, nil, 32)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, "First paragraph here.", chunks[0].Text)
+	assert.Equal(t, "Second one is longer than that.", chunks[1].Text)
+
+	// Entities crossing a cut are carried over with adjusted offsets
+	text = "aaaa bbbb cccc dddd"
+	entities := []gotelegrambot.MessageEntity{{Type: gotelegrambot.EntityTypeBold, Offset: 5, Length: 9}}
+	chunks = gotelegrambot.SplitText(text, entities, 10)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, "aaaa bbbb", chunks[0].Text)
+	assert.Equal(t, []gotelegrambot.MessageEntity{{Type: gotelegrambot.EntityTypeBold, Offset: 5, Length: 4}}, chunks[0].Entities)
+	assert.Equal(t, "cccc dddd", chunks[1].Text)
+	assert.Equal(t, []gotelegrambot.MessageEntity{{Type: gotelegrambot.EntityTypeBold, Offset: 0, Length: 4}}, chunks[1].Entities)
+
+	// Links are never cut in half
+	text = "see https://example.com/x"
+	entities = []gotelegrambot.MessageEntity{{Type: gotelegrambot.EntityTypeURL, Offset: 4, Length: 21}}
+	chunks = gotelegrambot.SplitText(text, entities, 22)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, "see", chunks[0].Text)
+	assert.Equal(t, "https://example.com/x", chunks[1].Text)
+
+	// Surrogate pairs are never split, and every chunk respects the limit
+	text = strings.Repeat("😀", 10)
+	chunks = gotelegrambot.SplitText(text, nil, 5)
+	for _, chunk := range chunks {
+		assert.LessOrEqual(t, gotelegrambot.UTF16Len(chunk.Text), 5)
+		assert.NotContains(t, chunk.Text, "�")
+	}
+	assert.Equal(t, text, chunks[0].Text+chunks[1].Text+chunks[2].Text+chunks[3].Text+chunks[4].Text)
+}
+
+func TestSplitHTML(t *testing.T) {
+	text := "<b>bold text <i>and italic</i> words</b> &amp; more"
+	chunks := gotelegrambot.SplitHTML(text, 20)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, "<b>bold text <i>and italic</i></b>", chunks[0])
+	assert.Equal(t, "<b>words</b> &amp; more", chunks[1])
+
+	chunks = gotelegrambot.SplitHTML("<i>one two three four</i>", 9)
+	assert.Equal(t, []string{"<i>one two</i>", "<i>three</i>", "<i>four</i>"}, chunks)
+}
+
+func TestSplitCaption(t *testing.T) {
+	caption := strings.Repeat("word ", 300)
+	head, rest, err := gotelegrambot.SplitCaption(caption, nil, "")
+	require.NoError(t, err)
+	assert.LessOrEqual(t, gotelegrambot.UTF16Len(head.Text), gotelegrambot.MaxCaptionLength)
+	require.Len(t, rest, 1)
+
+	_, _, err = gotelegrambot.SplitCaption(caption, nil, gotelegrambot.ParseModeMarkdownV2)
+	assert.Error(t, err)
+}
+
+func TestSendLongMessage(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	group := telegramtest.NewGroupChat(-100123, "Group")
+	h.Server.AddChat(group)
+
+	// Three paragraphs, each too long to share a message with the next
+	text := strings.Repeat("a", 4000) + "\n\n" + strings.Repeat("b", 4000) + "\n\n" + strings.Repeat("c", 100)
+	entities := []gotelegrambot.MessageEntity{
+		{Type: gotelegrambot.EntityTypeBold, Offset: 4002, Length: 4000},
+		{Type: gotelegrambot.EntityTypeItalic, Offset: 8004, Length: 100},
+	}
+	keyboard := gotelegrambot.NewInlineKeyboardMarkup([]gotelegrambot.InlineKeyboardButton{
+		gotelegrambot.NewInlineKeyboardButtonCallback("More", "more"),
+	})
+
+	messages, err := h.Bot.SendLongMessage(context.Background(), gotelegrambot.NewChatID(group.ID), text,
+		gotelegrambot.WithEntities(entities),
+		gotelegrambot.WithReplyToMessageID(5),
+		gotelegrambot.WithReplyMarkup(keyboard))
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	assert.Equal(t, strings.Repeat("a", 4000), messages[0].Text)
+	assert.Equal(t, strings.Repeat("c", 100), messages[2].Text)
+
+	h.ExpectCalls("sendMessage", "sendMessage", "sendMessage")
+	h.ExpectCall("sendMessage").
+		Param("text", strings.Repeat("a", 4000)).
+		Param("reply_parameters", map[string]interface{}{"message_id": 5}).
+		NoParam("entities").
+		NoParam("reply_markup")
+	h.ExpectCall("sendMessage").
+		Param("text", strings.Repeat("b", 4000)).
+		Param("entities", []gotelegrambot.MessageEntity{{Type: gotelegrambot.EntityTypeBold, Offset: 0, Length: 4000}}).
+		NoParam("reply_parameters").
+		NoParam("reply_markup")
+	h.ExpectCall("sendMessage").
+		Param("text", strings.Repeat("c", 100)).
+		Param("entities", []gotelegrambot.MessageEntity{{Type: gotelegrambot.EntityTypeItalic, Offset: 0, Length: 100}}).
+		Param("reply_markup", keyboard).
+		NoParam("reply_parameters")
+}
+
+func TestSendLongMessageFailure(t *testing.T) {
+	h := telegramtest.NewHarness(t)
+	group := telegramtest.NewGroupChat(-100123, "Group")
+
+	sent := 0
+	h.Server.Handle("sendMessage", func(call telegramtest.Call) (interface{}, error) {
+		sent++
+		if sent == 2 {
+			return nil, telegramtest.Failure{Code: 400, Description: "Bad Request: message is too long"}
+		}
+		return gotelegrambot.Message{MessageID: sent, Chat: &group, Text: call.String("text")}, nil
+	})
+
+	text := strings.Repeat("a", 4000) + "\n\n" + strings.Repeat("b", 4000) + "\n\n" + strings.Repeat("c", 100)
+	messages, err := h.Bot.SendLongMessage(context.Background(), gotelegrambot.NewChatID(group.ID), text)
+	assert.ErrorContains(t, err, "failed to send part 2 of 3")
+	require.Len(t, messages, 1)
+	assert.Equal(t, 1, messages[0].MessageID)
+	h.ExpectCalls("sendMessage", "sendMessage")
+}
@@ -0,0 +1,266 @@
+package telegramtest
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf16"
+
+	"github.com/KazeDevID/gotelegrambot"
+)
+
+// lastID feeds the IDs of built messages and queries, so they don't collide.
+var lastID int64 = 1000
+
+func nextID() int64 {
+	return atomic.AddInt64(&lastID, 1)
+}
+
+// UpdateSource is anything that can produce an update, such as the builders
+// of this package.
+type UpdateSource interface {
+	Update() gotelegrambot.Update
+}
+
+// NewUser creates a user that is not a bot.
+func NewUser(id int64, firstName string) gotelegrambot.User {
+	return gotelegrambot.User{ID: id, FirstName: firstName}
+}
+
+// NewPrivateChat creates the private chat of a user with the bot.
+func NewPrivateChat(user gotelegrambot.User) gotelegrambot.Chat {
+	return gotelegrambot.Chat{
+		ID:        user.ID,
+		Type:      "private",
+		FirstName: user.FirstName,
+		Username:  user.Username,
+	}
+}
+
+// NewGroupChat creates a supergroup chat.
+func NewGroupChat(id int64, title string) gotelegrambot.Chat {
+	return gotelegrambot.Chat{ID: id, Type: "supergroup", Title: title}
+}
+
+// MessageBuilder builds a message update.
+type MessageBuilder struct {
+	message gotelegrambot.Message
+	edited  bool
+}
+
+// NewTextMessage starts building a text message sent by a user to a chat.
+// Text starting with a slash gets a bot_command entity.
+func NewTextMessage(from gotelegrambot.User, chat gotelegrambot.Chat, text string) *MessageBuilder {
+	b := newMessage(from, chat)
+	b.message.Text = text
+
+	if strings.HasPrefix(text, "/") {
+		command := strings.Fields(text)[0]
+		b.message.Entities = []gotelegrambot.MessageEntity{{
+			Type:   "bot_command",
+			Offset: 0,
+			Length: len(utf16.Encode([]rune(command))),
+		}}
+	}
+	return b
+}
+
+// NewPhotoMessage starts building a photo message with a caption.
+func NewPhotoMessage(from gotelegrambot.User, chat gotelegrambot.Chat, caption string) *MessageBuilder {
+	b := newMessage(from, chat)
+	b.message.Caption = caption
+
+	id := strconv.FormatInt(b.message.Chat.ID, 10) + "-" + strconv.Itoa(b.message.MessageID)
+	b.message.Photo = []gotelegrambot.PhotoSize{
+		{FileID: "photo-small-" + id, FileUniqueID: "small-" + id, Width: 90, Height: 90, FileSize: 1024},
+		{FileID: "photo-large-" + id, FileUniqueID: "large-" + id, Width: 1280, Height: 1280, FileSize: 102400},
+	}
+	return b
+}
+
+func newMessage(from gotelegrambot.User, chat gotelegrambot.Chat) *MessageBuilder {
+	return &MessageBuilder{
+		message: gotelegrambot.Message{
+			MessageID: int(nextID()),
+			From:      &from,
+			Date:      int(time.Now().Unix()),
+			Chat:      &chat,
+		},
+	}
+}
+
+// ID sets the message ID.
+func (b *MessageBuilder) ID(messageID int) *MessageBuilder {
+	b.message.MessageID = messageID
+	return b
+}
+
+// ReplyTo makes the message a reply to another one.
+func (b *MessageBuilder) ReplyTo(message *gotelegrambot.Message) *MessageBuilder {
+	b.message.ReplyToMessage = message
+	return b
+}
+
+// Entities sets the entities of the text, or of the caption for media messages.
+func (b *MessageBuilder) Entities(entities ...gotelegrambot.MessageEntity) *MessageBuilder {
+	if b.message.Photo != nil {
+		b.message.CaptionEntities = entities
+	} else {
+		b.message.Entities = entities
+	}
+	return b
+}
+
+// InTopic sends the message to a forum topic.
+func (b *MessageBuilder) InTopic(messageThreadID int) *MessageBuilder {
+	b.message.MessageThreadID = messageThreadID
+	b.message.IsTopicMessage = true
+	return b
+}
+
+// Edited delivers the message as an edited_message update.
+func (b *MessageBuilder) Edited() *MessageBuilder {
+	b.edited = true
+	b.message.EditDate = int(time.Now().Unix())
+	return b
+}
+
+// Message returns the built message.
+func (b *MessageBuilder) Message() *gotelegrambot.Message {
+	message := b.message
+	return &message
+}
+
+// Update returns an update carrying the message.
+func (b *MessageBuilder) Update() gotelegrambot.Update {
+	if b.edited {
+		return gotelegrambot.Update{EditedMessage: b.Message()}
+	}
+	return gotelegrambot.Update{Message: b.Message()}
+}
+
+// CallbackQueryBuilder builds a callback query update.
+type CallbackQueryBuilder struct {
+	query gotelegrambot.CallbackQuery
+}
+
+// NewCallbackQuery starts building the callback query of a user pressing a
+// button with the given data under a message.
+func NewCallbackQuery(from gotelegrambot.User, message *gotelegrambot.Message, data string) *CallbackQueryBuilder {
+	query := gotelegrambot.CallbackQuery{
+		ID:      strconv.FormatInt(nextID(), 10),
+		From:    &from,
+		Message: message,
+		Data:    data,
+	}
+	if message != nil && message.Chat != nil {
+		query.ChatInstance = strconv.FormatInt(message.Chat.ID, 10)
+	}
+	return &CallbackQueryBuilder{query: query}
+}
+
+// ID sets the query ID.
+func (b *CallbackQueryBuilder) ID(id string) *CallbackQueryBuilder {
+	b.query.ID = id
+	return b
+}
+
+// Inline makes the query come from a message sent in inline mode.
+func (b *CallbackQueryBuilder) Inline(inlineMessageID string) *CallbackQueryBuilder {
+	b.query.Message = nil
+	b.query.InlineMessageID = inlineMessageID
+	return b
+}
+
+// Update returns an update carrying the query.
+func (b *CallbackQueryBuilder) Update() gotelegrambot.Update {
+	query := b.query
+	return gotelegrambot.Update{CallbackQuery: &query}
+}
+
+// PollAnswerBuilder builds a poll answer update.
+type PollAnswerBuilder struct {
+	answer gotelegrambot.PollAnswer
+}
+
+// NewPollAnswer starts building the answer of a user to a non-anonymous poll.
+// No options means the vote was retracted.
+func NewPollAnswer(user gotelegrambot.User, pollID string, optionIDs ...int) *PollAnswerBuilder {
+	if optionIDs == nil {
+		optionIDs = []int{}
+	}
+	return &PollAnswerBuilder{answer: gotelegrambot.PollAnswer{
+		PollID:    pollID,
+		User:      &user,
+		OptionIDs: optionIDs,
+	}}
+}
+
+// Update returns an update carrying the answer.
+func (b *PollAnswerBuilder) Update() gotelegrambot.Update {
+	answer := b.answer
+	return gotelegrambot.Update{PollAnswer: &answer}
+}
+
+// PreCheckoutQueryBuilder builds a pre-checkout query update.
+type PreCheckoutQueryBuilder struct {
+	query gotelegrambot.PreCheckoutQuery
+}
+
+// NewPreCheckoutQuery starts building the pre-checkout query of a user paying
+// an invoice, with the total amount in the smallest units of the currency.
+func NewPreCheckoutQuery(from gotelegrambot.User, currency string, totalAmount int, payload string) *PreCheckoutQueryBuilder {
+	return &PreCheckoutQueryBuilder{query: gotelegrambot.PreCheckoutQuery{
+		ID:             strconv.FormatInt(nextID(), 10),
+		From:           &from,
+		Currency:       currency,
+		TotalAmount:    totalAmount,
+		InvoicePayload: payload,
+	}}
+}
+
+// ShippingOption sets the shipping option chosen by the user.
+func (b *PreCheckoutQueryBuilder) ShippingOption(id string) *PreCheckoutQueryBuilder {
+	b.query.ShippingOptionID = id
+	return b
+}
+
+// OrderInfo sets the order information given by the user.
+func (b *PreCheckoutQueryBuilder) OrderInfo(info gotelegrambot.OrderInfo) *PreCheckoutQueryBuilder {
+	b.query.OrderInfo = &info
+	return b
+}
+
+// Update returns an update carrying the query.
+func (b *PreCheckoutQueryBuilder) Update() gotelegrambot.Update {
+	query := b.query
+	return gotelegrambot.Update{PreCheckoutQuery: &query}
+}
+
+// ChatJoinRequestBuilder builds a chat join request update.
+type ChatJoinRequestBuilder struct {
+	request gotelegrambot.ChatJoinRequest
+}
+
+// NewChatJoinRequest starts building the request of a user to join a chat.
+func NewChatJoinRequest(from gotelegrambot.User, chat gotelegrambot.Chat) *ChatJoinRequestBuilder {
+	return &ChatJoinRequestBuilder{request: gotelegrambot.ChatJoinRequest{
+		Chat:       &chat,
+		From:       &from,
+		UserChatID: from.ID,
+		Date:       int(time.Now().Unix()),
+	}}
+}
+
+// Bio sets the bio of the user.
+func (b *ChatJoinRequestBuilder) Bio(bio string) *ChatJoinRequestBuilder {
+	b.request.Bio = bio
+	return b
+}
+
+// Update returns an update carrying the request.
+func (b *ChatJoinRequestBuilder) Update() gotelegrambot.Update {
+	request := b.request
+	return gotelegrambot.Update{ChatJoinRequest: &request}
+}
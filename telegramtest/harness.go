@@ -0,0 +1,273 @@
+package telegramtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/KazeDevID/gotelegrambot"
+)
+
+// Harness runs update handlers against a fake server and checks the API
+// calls they make:
+//
+//	h := telegramtest.NewHarness(t)
+//	alice := telegramtest.NewUser(42, "Alice")
+//	err := h.Run(handler, telegramtest.NewTextMessage(alice, telegramtest.NewPrivateChat(alice), "/start"))
+//	require.NoError(t, err)
+//	h.ExpectCall("sendMessage").TextMatches(`^Welcome`).InlineButtons(3)
+//
+// Callback routes and other hooks registered on Bot run as they would in
+// production, before the handler.
+type Harness struct {
+	Server *Server
+	Bot    *gotelegrambot.Bot
+
+	t      testing.TB
+	cursor int
+}
+
+// NewHarness creates a Harness with a bot that doesn't retry failed calls.
+// The server is closed when the test ends.
+func NewHarness(t testing.TB, options ...gotelegrambot.BotOption) *Harness {
+	t.Helper()
+
+	server := NewServer()
+	t.Cleanup(server.Close)
+
+	options = append([]gotelegrambot.BotOption{server.BotOption(), gotelegrambot.WithRetryCount(0)}, options...)
+	bot, err := gotelegrambot.New(Token, options...)
+	if err != nil {
+		t.Fatalf("failed to create bot: %v", err)
+	}
+
+	return &Harness{Server: server, Bot: bot, t: t}
+}
+
+// Run delivers an update to the bot and runs its hooks and the handler, which
+// may be nil to only run the hooks. The messages of the update, or the chat
+// and applicant of a join request, are added to the server first, and the
+// calls recorded so far are reset. Run returns the error of the handler or
+// hooks.
+func (h *Harness) Run(handler gotelegrambot.UpdateHandler, source UpdateSource) error {
+	h.t.Helper()
+
+	update := source.Update()
+	for _, message := range []*gotelegrambot.Message{update.Message, update.EditedMessage, update.ChannelPost, update.EditedChannelPost} {
+		h.Server.AddMessage(message)
+	}
+	if update.CallbackQuery != nil {
+		h.Server.AddMessage(update.CallbackQuery.Message)
+	}
+	if request := update.ChatJoinRequest; request != nil {
+		// The bot may write to the applicant until the request is resolved
+		h.Server.AddChat(*request.Chat)
+		h.Server.AddUser(*request.From)
+	}
+
+	h.Server.ResetCalls()
+	h.cursor = 0
+
+	data, err := json.Marshal(update)
+	if err != nil {
+		h.t.Fatalf("failed to marshal update: %v", err)
+	}
+
+	var handlerErr error
+	captured := func(ctx context.Context, update *gotelegrambot.Update) error {
+		if handler == nil {
+			return nil
+		}
+		handlerErr = handler(ctx, update)
+		return handlerErr
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	h.Bot.WebhookHandler(captured).ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusOK && handlerErr == nil {
+		return &HarnessError{Status: recorder.Code, Body: strings.TrimSpace(recorder.Body.String())}
+	}
+	return handlerErr
+}
+
+// HarnessError is returned by Run when the hooks registered on the bot fail.
+// The error itself is logged by the bot.
+type HarnessError struct {
+	Status int
+	Body   string
+}
+
+// Error implements the error interface.
+func (e *HarnessError) Error() string {
+	return "update processing failed: " + e.Body
+}
+
+// Calls returns the calls made by the last run.
+func (h *Harness) Calls() []Call {
+	return h.Server.Calls()
+}
+
+// ExpectCalls checks that the last run made exactly the given calls, in order.
+func (h *Harness) ExpectCalls(methods ...string) {
+	h.t.Helper()
+
+	var made []string
+	for _, call := range h.Server.Calls() {
+		made = append(made, call.Method)
+	}
+	if !reflect.DeepEqual(made, methods) && (len(made) != 0 || len(methods) != 0) {
+		h.t.Errorf("expected calls %v, got %v", methods, made)
+	}
+}
+
+// ExpectNoCalls checks that the last run made no calls.
+func (h *Harness) ExpectNoCalls() {
+	h.t.Helper()
+	h.ExpectCalls()
+}
+
+// ExpectCall checks that the last run called the method after the call
+// matched by the previous ExpectCall, so that successive expectations check
+// the order of calls. The returned assertion checks the params of the call.
+func (h *Harness) ExpectCall(method string) *CallAssertion {
+	h.t.Helper()
+
+	calls := h.Server.Calls()
+	for i := h.cursor; i < len(calls); i++ {
+		if calls[i].Method == method {
+			h.cursor = i + 1
+			return &CallAssertion{t: h.t, call: &calls[i], method: method}
+		}
+	}
+
+	h.t.Errorf("expected a call to %s after %d matched calls, got %v", method, h.cursor, methodNames(calls))
+	return &CallAssertion{t: h.t, method: method}
+}
+
+func methodNames(calls []Call) []string {
+	names := make([]string, len(calls))
+	for i, call := range calls {
+		names[i] = call.Method
+	}
+	return names
+}
+
+// CallAssertion checks the params of a call. Its checks do nothing when the
+// call was not made, which has already been reported.
+type CallAssertion struct {
+	t      testing.TB
+	call   *Call
+	method string
+}
+
+// Call returns the checked call, or a zero Call when it was not made.
+func (a *CallAssertion) Call() Call {
+	if a.call == nil {
+		return Call{Method: a.method}
+	}
+	return *a.call
+}
+
+// Param checks that a param equals want once both are encoded as JSON, so
+// that e.g. an int64 chat ID matches the decoded number.
+func (a *CallAssertion) Param(key string, want interface{}) *CallAssertion {
+	a.t.Helper()
+	if a.call == nil {
+		return a
+	}
+
+	got, ok := a.call.Params[key]
+	if !ok {
+		a.t.Errorf("%s: expected param %s, got none", a.method, key)
+		return a
+	}
+	if !reflect.DeepEqual(got, normalize(want)) {
+		a.t.Errorf("%s: expected param %s to be %v, got %v", a.method, key, want, got)
+	}
+	return a
+}
+
+// NoParam checks that a param was not sent.
+func (a *CallAssertion) NoParam(key string) *CallAssertion {
+	a.t.Helper()
+	if a.call == nil {
+		return a
+	}
+
+	if got, ok := a.call.Params[key]; ok {
+		a.t.Errorf("%s: expected no param %s, got %v", a.method, key, got)
+	}
+	return a
+}
+
+// TextMatches checks that the text, or the caption, matches a regular expression.
+func (a *CallAssertion) TextMatches(pattern string) *CallAssertion {
+	a.t.Helper()
+	if a.call == nil {
+		return a
+	}
+
+	text, ok := a.call.Params["text"].(string)
+	if !ok {
+		text, _ = a.call.Params["caption"].(string)
+	}
+	if !regexp.MustCompile(pattern).MatchString(text) {
+		a.t.Errorf("%s: expected text matching %q, got %q", a.method, pattern, text)
+	}
+	return a
+}
+
+// InlineButtons checks that the reply markup is an inline keyboard with the
+// given number of buttons in total.
+func (a *CallAssertion) InlineButtons(count int) *CallAssertion {
+	a.t.Helper()
+	if a.call == nil {
+		return a
+	}
+
+	markup := inlineMarkup(a.call.Params["reply_markup"])
+	if markup == nil {
+		a.t.Errorf("%s: expected an inline keyboard with %d buttons, got none", a.method, count)
+		return a
+	}
+
+	got := 0
+	for _, row := range markup.InlineKeyboard {
+		got += len(row)
+	}
+	if got != count {
+		a.t.Errorf("%s: expected an inline keyboard with %d buttons, got %d", a.method, count, got)
+	}
+	return a
+}
+
+// InlineKeyboard returns the inline keyboard of the reply markup, or nil.
+func (a *CallAssertion) InlineKeyboard() *gotelegrambot.InlineKeyboardMarkup {
+	if a.call == nil {
+		return nil
+	}
+	return inlineMarkup(a.call.Params["reply_markup"])
+}
+
+// normalize round-trips a value through JSON, as the server decodes params.
+func normalize(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
@@ -0,0 +1,128 @@
+package telegramtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHarness(t *testing.T) {
+	h := NewHarness(t)
+	alice := NewUser(42, "Alice")
+	chat := NewPrivateChat(alice)
+
+	start := func(ctx context.Context, update *gotelegrambot.Update) error {
+		assert.Equal(t, "bot_command", update.Message.Entities[0].Type)
+		keyboard := gotelegrambot.NewInlineKeyboardMarkup(
+			gotelegrambot.NewInlineKeyboardButtonRow(
+				gotelegrambot.NewInlineKeyboardButtonCallback("One", "pick:1"),
+				gotelegrambot.NewInlineKeyboardButtonCallback("Two", "pick:2"),
+			),
+			gotelegrambot.NewInlineKeyboardButtonRow(
+				gotelegrambot.NewInlineKeyboardButtonCallback("Three", "pick:3"),
+			),
+		)
+		_, err := h.Bot.SendMessage(ctx, update.Message.ChatID(), "Welcome, "+update.Message.From.FirstName,
+			gotelegrambot.WithReplyMarkup(keyboard))
+		return err
+	}
+
+	require.NoError(t, h.Run(start, NewTextMessage(alice, chat, "/start")))
+	h.ExpectCalls("sendMessage")
+	h.ExpectCall("sendMessage").
+		Param("chat_id", 42).
+		TextMatches(`^Welcome, Alice$`).
+		InlineButtons(3)
+
+	sent := h.Server.LastMessage(42)
+	require.NotNil(t, sent)
+
+	h.Bot.OnCallbackQuery("pick:", func(ctx context.Context, query *gotelegrambot.CallbackQuery) error {
+		if err := h.Bot.AnswerCallbackQuery(ctx, query.ID); err != nil {
+			return err
+		}
+		_, err := h.Bot.EditMessageText(ctx,
+			gotelegrambot.WithChatID(query.Message.ChatID()),
+			gotelegrambot.WithMessageID(query.Message.MessageID),
+			gotelegrambot.WithText("Picked "+query.Data[len("pick:"):]))
+		return err
+	})
+
+	require.NoError(t, h.Run(nil, NewCallbackQuery(alice, sent, "pick:2")))
+	h.ExpectCalls("answerCallbackQuery", "editMessageText")
+	h.ExpectCall("answerCallbackQuery")
+	h.ExpectCall("editMessageText").
+		Param("message_id", sent.MessageID).
+		TextMatches(`Picked 2`).
+		NoParam("reply_markup")
+	assert.Equal(t, "Picked 2", h.Server.LastMessage(42).Text)
+}
+
+func TestHarnessFailedExpectations(t *testing.T) {
+	h := NewHarness(t)
+	alice := NewUser(42, "Alice")
+
+	reply := func(ctx context.Context, update *gotelegrambot.Update) error {
+		_, err := h.Bot.SendMessage(ctx, update.Message.ChatID(), "hi")
+		return err
+	}
+	require.NoError(t, h.Run(reply, NewTextMessage(alice, NewPrivateChat(alice), "hello")))
+
+	recorder := &failureRecorder{TB: t}
+	h.t = recorder
+	h.ExpectCalls("sendPhoto")
+	h.ExpectCall("sendMessage").TextMatches("bye").InlineButtons(1)
+	h.ExpectCall("sendMessage").Param("text", "hi")
+	assert.Equal(t, []string{
+		"expected calls [sendPhoto], got [sendMessage]",
+		`sendMessage: expected text matching "bye", got "hi"`,
+		"sendMessage: expected an inline keyboard with 1 buttons, got none",
+		"expected a call to sendMessage after 1 matched calls, got [sendMessage]",
+	}, recorder.failures)
+}
+
+func TestBuilders(t *testing.T) {
+	alice := NewUser(42, "Alice")
+	group := NewGroupChat(-100123, "Group")
+
+	message := NewTextMessage(alice, group, "/help@test_bot me").InTopic(7).Edited().Update()
+	require.NotNil(t, message.EditedMessage)
+	assert.Equal(t, 14, message.EditedMessage.Entities[0].Length)
+	assert.Equal(t, 7, message.EditedMessage.MessageThreadID)
+	assert.Equal(t, "edited_message", gotelegrambot.UpdateType(&message))
+
+	photo := NewPhotoMessage(alice, group, "look").Update()
+	require.Len(t, photo.Message.Photo, 2)
+	assert.Equal(t, "look", photo.Message.Caption)
+
+	answer := NewPollAnswer(alice, "poll-1", 0, 2).Update()
+	assert.Equal(t, []int{0, 2}, answer.PollAnswer.OptionIDs)
+
+	checkout := NewPreCheckoutQuery(alice, "USD", 999, "order-1").ShippingOption("express").Update()
+	assert.Equal(t, "express", checkout.PreCheckoutQuery.ShippingOptionID)
+	assert.Equal(t, 999, checkout.PreCheckoutQuery.TotalAmount)
+
+	query := NewCallbackQuery(alice, nil, "data").Inline("inline-1").Update()
+	assert.Equal(t, "inline-1", query.CallbackQuery.InlineMessageID)
+
+	request := NewChatJoinRequest(alice, group).Bio("hi").Update()
+	assert.Equal(t, int64(42), request.ChatJoinRequest.UserChatID)
+	assert.Equal(t, "hi", request.ChatJoinRequest.Bio)
+	assert.Equal(t, "chat_join_request", gotelegrambot.UpdateType(&request))
+}
+
+// failureRecorder records failed expectations instead of failing the test.
+type failureRecorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *failureRecorder) Helper() {}
+
+func (r *failureRecorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,301 @@
+package telegramtest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/KazeDevID/gotelegrambot"
+)
+
+// handle answers a call with the server's default behavior. Methods without
+// one succeed with true.
+func (s *Server) handle(ctx context.Context, call Call) (interface{}, error) {
+	switch call.Method {
+	case "getMe":
+		return s.bot, nil
+	case "getUpdates":
+		return s.getUpdates(ctx, call)
+	case "setWebhook":
+		return s.setWebhook(call)
+	case "deleteWebhook":
+		return s.deleteWebhook(call)
+	case "getWebhookInfo":
+		return s.getWebhookInfo()
+	case "getChat":
+		return s.getChat(call)
+	case "sendMessage":
+		return s.sendMessage(call, call.String("text"))
+	case "sendPhoto", "sendDocument", "sendVideo", "sendAudio", "sendAnimation", "sendVoice":
+		return s.sendMessage(call, call.String("caption"))
+	case "sendPoll", "sendInvoice":
+		return s.sendMessage(call, "")
+	case "forwardMessage", "copyMessage":
+		return s.forwardMessage(call)
+	case "editMessageText":
+		return s.editMessage(call, true)
+	case "editMessageReplyMarkup":
+		return s.editMessage(call, false)
+	case "deleteMessage":
+		return s.deleteMessage(call)
+	}
+	return true, nil
+}
+
+func (s *Server) getUpdates(ctx context.Context, call Call) (interface{}, error) {
+	offset := int(call.Int64("offset"))
+	limit := int(call.Int64("limit"))
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+	timeout := time.Duration(call.Int64("timeout")) * time.Second
+	if timeout > maxPollTimeout {
+		timeout = maxPollTimeout
+	}
+
+	deadline := time.After(timeout)
+	for {
+		s.mu.Lock()
+		if s.webhookURL != "" {
+			s.mu.Unlock()
+			return nil, Failure{
+				Code:        http.StatusConflict,
+				Description: "Conflict: can't use getUpdates method while webhook is active; use deleteWebhook to delete the webhook first",
+			}
+		}
+
+		// Updates below the offset are confirmed and forgotten
+		pending := s.updates[:0]
+		for _, update := range s.updates {
+			if update.UpdateID >= offset {
+				pending = append(pending, update)
+			}
+		}
+		s.updates = pending
+
+		if len(pending) > 0 || timeout <= 0 {
+			if len(pending) > limit {
+				pending = pending[:limit]
+			}
+			updates := append([]gotelegrambot.Update{}, pending...)
+			s.mu.Unlock()
+			return updates, nil
+		}
+
+		newUpdate := s.newUpdate
+		s.mu.Unlock()
+
+		select {
+		case <-newUpdate:
+		case <-deadline:
+			timeout = 0
+		case <-ctx.Done():
+			return []gotelegrambot.Update{}, nil
+		}
+	}
+}
+
+func (s *Server) setWebhook(call Call) (interface{}, error) {
+	url := call.String("url")
+	if url == "" {
+		return s.deleteWebhook(call)
+	}
+
+	secret := call.String("secret_token")
+
+	s.mu.Lock()
+	s.webhookURL = url
+	s.webhookSecret = secret
+	pending := s.updates
+	s.updates = nil
+	if call.Params["drop_pending_updates"] == true {
+		pending = nil
+	}
+	s.mu.Unlock()
+
+	for _, update := range pending {
+		if err := postUpdate(url, secret, update); err != nil {
+			return nil, err
+		}
+	}
+	return true, nil
+}
+
+func (s *Server) deleteWebhook(call Call) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.webhookURL = ""
+	s.webhookSecret = ""
+	if call.Params["drop_pending_updates"] == true {
+		s.updates = nil
+	}
+	return true, nil
+}
+
+func (s *Server) getWebhookInfo() (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return gotelegrambot.WebhookInfo{
+		URL:                s.webhookURL,
+		PendingUpdateCount: len(s.updates),
+	}, nil
+}
+
+// chat returns the chat targeted by the call's chat_id. s.mu must be held.
+func (s *Server) chat(call Call, key string) (gotelegrambot.Chat, error) {
+	if username, ok := call.Params[key].(string); ok && len(username) > 1 && username[0] == '@' {
+		for _, chat := range s.chats {
+			if "@"+chat.Username == username {
+				return chat, nil
+			}
+		}
+		return gotelegrambot.Chat{}, badRequest("chat not found")
+	}
+
+	chat, ok := s.chats[call.Int64(key)]
+	if !ok {
+		return gotelegrambot.Chat{}, badRequest("chat not found")
+	}
+	return chat, nil
+}
+
+func (s *Server) getChat(call Call) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.chat(call, "chat_id")
+}
+
+func (s *Server) sendMessage(call Call, text string) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chat, err := s.chat(call, "chat_id")
+	if err != nil {
+		return nil, err
+	}
+
+	if call.Method == "sendMessage" && text == "" {
+		return nil, badRequest("message text is empty")
+	}
+
+	bot := s.bot
+	message := s.addMessage(chat, &bot, text)
+	message.MessageThreadID = int(call.Int64("message_thread_id"))
+	if replyTo := replyToMessageID(call); replyTo != 0 {
+		message.ReplyToMessage = s.findMessage(chat.ID, replyTo)
+	}
+	message.ReplyMarkup = inlineMarkup(call.Params["reply_markup"])
+	return *message, nil
+}
+
+func (s *Server) forwardMessage(call Call) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chat, err := s.chat(call, "chat_id")
+	if err != nil {
+		return nil, err
+	}
+	fromChat, err := s.chat(call, "from_chat_id")
+	if err != nil {
+		return nil, err
+	}
+
+	original := s.findMessage(fromChat.ID, int(call.Int64("message_id")))
+	if original == nil {
+		return nil, badRequest("message to forward not found")
+	}
+
+	bot := s.bot
+	message := s.addMessage(chat, &bot, original.Text)
+	if call.Method == "copyMessage" {
+		return gotelegrambot.MessageID{MessageID: message.MessageID}, nil
+	}
+	message.ForwardOrigin = &gotelegrambot.MessageOrigin{
+		Type: "user",
+		Date: original.Date,
+		User: &gotelegrambot.MessageOriginUser{Date: original.Date, SenderUser: original.From},
+	}
+	return *message, nil
+}
+
+func (s *Server) editMessage(call Call, editText bool) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chat, err := s.chat(call, "chat_id")
+	if err != nil {
+		return nil, err
+	}
+
+	message := s.findMessage(chat.ID, int(call.Int64("message_id")))
+	if message == nil {
+		return nil, badRequest("message to edit not found")
+	}
+
+	text := message.Text
+	if editText {
+		text = call.String("text")
+	}
+	markup := inlineMarkup(call.Params["reply_markup"])
+	if text == message.Text && reflect.DeepEqual(markup, message.ReplyMarkup) {
+		return nil, badRequest("message is not modified: specified new message content and reply markup are exactly the same as a current content and reply markup of the message")
+	}
+
+	message.Text = text
+	message.ReplyMarkup = markup
+	message.EditDate = int(time.Now().Unix())
+	return *message, nil
+}
+
+func (s *Server) deleteMessage(call Call) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	chat, err := s.chat(call, "chat_id")
+	if err != nil {
+		return nil, err
+	}
+
+	messageID := int(call.Int64("message_id"))
+	history := s.history[chat.ID]
+	for i, message := range history {
+		if message.MessageID == messageID {
+			s.history[chat.ID] = append(history[:i], history[i+1:]...)
+			return true, nil
+		}
+	}
+	return nil, badRequest("message to delete not found")
+}
+
+// replyToMessageID returns the message the call replies to, or 0.
+func replyToMessageID(call Call) int {
+	var reply gotelegrambot.ReplyParameters
+	if err := json.Unmarshal([]byte(call.String("reply_parameters")), &reply); err != nil {
+		return 0
+	}
+	return reply.MessageID
+}
+
+// inlineMarkup decodes a reply_markup param holding an inline keyboard.
+func inlineMarkup(value interface{}) *gotelegrambot.InlineKeyboardMarkup {
+	if value == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var markup gotelegrambot.InlineKeyboardMarkup
+	if err := json.Unmarshal(data, &markup); err != nil || len(markup.InlineKeyboard) == 0 {
+		return nil
+	}
+	return &markup
+}
@@ -0,0 +1,288 @@
+package telegramtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// UpdateGoldenEnv is the environment variable that switches recorders to
+// recording when set to a non-empty value, refreshing their golden files.
+const UpdateGoldenEnv = "TELEGRAMTEST_UPDATE_GOLDEN"
+
+// tokenPattern matches bot tokens, which are scrubbed from recordings.
+var tokenPattern = regexp.MustCompile(`\d{5,}:[A-Za-z0-9_-]{30,}`)
+
+// scrubbedToken replaces tokens in recordings.
+const scrubbedToken = "<token>"
+
+// Interaction is a recorded API call and its response.
+type Interaction struct {
+	Method   string          `json:"method"`
+	Params   interface{}     `json:"params,omitempty"`
+	Status   int             `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Body     []byte          `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper that records Bot API traffic to a golden
+// file and replays it. In replay mode, requests are matched on the method name
+// and normalized params to the first interaction not replayed yet.
+//
+//	recorder, err := telegramtest.NewRecorder("testdata/session.json")
+//	defer recorder.Close()
+//	bot, _ := gotelegrambot.New(token, gotelegrambot.WithHTTPClient(recorder.Client()))
+type Recorder struct {
+	path      string
+	recording bool
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// RecorderOption is a function that configures a Recorder.
+type RecorderOption func(*recorderOptions)
+
+// recorderOptions represents options for NewRecorder.
+type recorderOptions struct {
+	Recording bool
+	Transport http.RoundTripper
+}
+
+// WithRecording forces the recorder to record, or to replay, regardless of UpdateGoldenEnv.
+func WithRecording(recording bool) RecorderOption {
+	return func(o *recorderOptions) {
+		o.Recording = recording
+	}
+}
+
+// WithTransport sets the transport real requests are sent with while recording.
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(o *recorderOptions) {
+		o.Transport = transport
+	}
+}
+
+func defaultRecorderOptions() recorderOptions {
+	return recorderOptions{
+		Recording: os.Getenv(UpdateGoldenEnv) != "",
+		Transport: http.DefaultTransport,
+	}
+}
+
+// NewRecorder creates a Recorder for the golden file at path. When replaying,
+// the file is loaded and must exist. When recording, it is written by Close.
+func NewRecorder(path string, options ...RecorderOption) (*Recorder, error) {
+	opts := defaultRecorderOptions()
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	r := &Recorder{
+		path:      path,
+		recording: opts.Recording,
+		transport: opts.Transport,
+	}
+
+	if r.recording {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read golden file, record it with %s=1", UpdateGoldenEnv)
+	}
+	if err := json.Unmarshal(data, &r.interactions); err != nil {
+		return nil, errors.Wrap(err, "failed to parse golden file")
+	}
+	r.replayed = make([]bool, len(r.interactions))
+
+	return r, nil
+}
+
+// Recording reports whether the recorder records rather than replays.
+func (r *Recorder) Recording() bool {
+	return r.recording
+}
+
+// Client returns an HTTP client using the recorder, for gotelegrambot.WithHTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip records or replays a request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read request body")
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	method := requestMethod(req.URL.Path)
+	params, err := normalizeParams(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.recording {
+		return r.record(req, method, params)
+	}
+	return r.replay(req, method, params)
+}
+
+func (r *Recorder) record(req *http.Request, method string, params interface{}) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	interaction := Interaction{
+		Method: method,
+		Params: params,
+		Status: resp.StatusCode,
+	}
+	scrubbed := tokenPattern.ReplaceAll(data, []byte(scrubbedToken))
+	if json.Valid(scrubbed) {
+		interaction.Response = scrubbed
+	} else {
+		interaction.Body = data
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, method string, params interface{}) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.replayed[i] || interaction.Method != method || !reflect.DeepEqual(interaction.Params, params) {
+			continue
+		}
+		r.replayed[i] = true
+
+		body := interaction.Body
+		if interaction.Response != nil {
+			body = interaction.Response
+		}
+		return &http.Response{
+			StatusCode:    interaction.Status,
+			Status:        http.StatusText(interaction.Status),
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	data, _ := json.Marshal(params)
+	return nil, errors.Errorf("no recorded interaction for %s with params %s", method, data)
+}
+
+// Unreplayed returns the recorded interactions that were not replayed, which
+// usually means the code under test stopped making some calls.
+func (r *Recorder) Unreplayed() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var interactions []Interaction
+	for i, interaction := range r.interactions {
+		if !r.replayed[i] {
+			interactions = append(interactions, interaction)
+		}
+	}
+	return interactions
+}
+
+// Close writes the golden file when recording.
+func (r *Recorder) Close() error {
+	if !r.recording {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	r.mu.Lock()
+	err := encoder.Encode(r.interactions)
+	r.mu.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal interactions")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return errors.Wrap(err, "failed to create golden file directory")
+	}
+	return errors.Wrap(os.WriteFile(r.path, buf.Bytes(), 0o644), "failed to write golden file")
+}
+
+// requestMethod returns the Bot API method of a request path, or the file
+// path prefixed with "file/" for file downloads.
+func requestMethod(path string) string {
+	if i := strings.Index(path, "/file/bot"); i >= 0 {
+		rest := path[i+len("/file/bot"):]
+		if j := strings.Index(rest, "/"); j >= 0 {
+			return "file/" + rest[j+1:]
+		}
+	}
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// normalizeParams decodes the params of a request into plain JSON values with
+// tokens scrubbed, so that equal params compare equal however they were sent.
+func normalizeParams(req *http.Request, body []byte) (interface{}, error) {
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+
+	params, err := readParams(clone)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read request params")
+	}
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal params")
+	}
+	data = tokenPattern.ReplaceAll(data, []byte(scrubbedToken))
+
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, errors.Wrap(err, "failed to normalize params")
+	}
+	return normalized, nil
+}
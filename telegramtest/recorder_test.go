@@ -0,0 +1,56 @@
+package telegramtest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	golden := filepath.Join(t.TempDir(), "session.json")
+	secret := "987654321:AAHdqTcvCH1vGWJxfSeofSAs0K5PALDsaw"
+	ctx := context.Background()
+
+	// Record a session against the fake server
+	server := NewServer()
+	server.AddUser(gotelegrambot.User{ID: 42, FirstName: "Alice"})
+
+	recorder, err := NewRecorder(golden, WithRecording(true))
+	require.NoError(t, err)
+	bot, _ := gotelegrambot.New(Token, server.BotOption(), gotelegrambot.WithHTTPClient(recorder.Client()))
+
+	message, err := bot.SendMessage(ctx, gotelegrambot.NewChatID(42), "token "+secret)
+	require.NoError(t, err)
+	_, err = bot.SendMessage(ctx, gotelegrambot.NewChatID(7), "hello")
+	require.Error(t, err)
+	require.NoError(t, recorder.Close())
+	server.Close()
+
+	data, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), secret)
+	assert.Contains(t, string(data), scrubbedToken)
+
+	// Replay it without a server
+	recorder, err = NewRecorder(golden, WithRecording(false))
+	require.NoError(t, err)
+	bot, _ = gotelegrambot.New(Token, gotelegrambot.WithAPIEndpoint("http://telegram.invalid/bot"), gotelegrambot.WithHTTPClient(recorder.Client()), gotelegrambot.WithRetryCount(0))
+
+	replayed, err := bot.SendMessage(ctx, gotelegrambot.NewChatID(42), "token "+secret)
+	require.NoError(t, err)
+	assert.Equal(t, message.MessageID, replayed.MessageID)
+	assert.Equal(t, "token "+scrubbedToken, replayed.Text)
+
+	_, err = bot.SendMessage(ctx, gotelegrambot.NewChatID(7), "hello")
+	assert.ErrorIs(t, err, gotelegrambot.ErrChatNotFound)
+	assert.Empty(t, recorder.Unreplayed())
+
+	// Calls that were not recorded fail
+	_, err = bot.SendMessage(ctx, gotelegrambot.NewChatID(42), "something else")
+	assert.Error(t, err)
+}
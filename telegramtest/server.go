@@ -0,0 +1,619 @@
+// Package telegramtest provides an in-process fake Telegram Bot API server
+// for testing bots offline.
+//
+// The server keeps chats, users and message history, delivers injected
+// updates through getUpdates or a webhook, records every call and can be told
+// to fail calls with rate limit, server or conflict errors:
+//
+//	server := telegramtest.NewServer()
+//	defer server.Close()
+//
+//	bot, _ := gotelegrambot.New(telegramtest.Token, server.BotOption())
+//	server.AddUser(gotelegrambot.User{ID: 42, FirstName: "Alice"})
+//	server.SendUserMessage(42, 42, "/start")
+package telegramtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/pkg/errors"
+)
+
+// Token is the bot token accepted by the server.
+const Token = "123456:TEST-TOKEN"
+
+// BotUserID is the user ID of the bot served by the server.
+const BotUserID = 123456
+
+// maxPollTimeout caps the long polling timeout so tests don't hang.
+const maxPollTimeout = time.Second
+
+// Call is a recorded Bot API call.
+type Call struct {
+	Method string
+	Params map[string]interface{}
+
+	// Files holds the contents of the files uploaded with a multipart
+	// request, by param name. Their params are set to "attach://<name>".
+	Files map[string][]byte
+}
+
+// String returns the param as a string, or "" when it is missing.
+func (c Call) String(key string) string {
+	switch v := c.Params[key].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// Int64 returns the param as an integer, or 0 when it is missing or not a number.
+func (c Call) Int64(key string) int64 {
+	switch v := c.Params[key].(type) {
+	case float64:
+		return int64(v)
+	case string:
+		n, _ := strconv.ParseInt(v, 10, 64)
+		return n
+	}
+	return 0
+}
+
+// Failure is an error response returned instead of handling a call.
+type Failure struct {
+	Code            int
+	Description     string
+	RetryAfter      int
+	MigrateToChatID int64
+}
+
+// Error returns the description of the failure.
+func (f Failure) Error() string {
+	return f.Description
+}
+
+// Handler answers a call in place of the server's default behavior. It
+// returns the result to send, or an error: a Failure is sent as is, any other
+// error as a 400 response.
+type Handler func(call Call) (interface{}, error)
+
+// Server is a fake Bot API server.
+type Server struct {
+	// URL is the base URL of the server, e.g. http://127.0.0.1:1234.
+	URL string
+
+	server *httptest.Server
+
+	mu            sync.Mutex
+	bot           gotelegrambot.User
+	users         map[int64]gotelegrambot.User
+	chats         map[int64]gotelegrambot.Chat
+	history       map[int64][]*gotelegrambot.Message
+	files         map[string][]byte
+	updates       []gotelegrambot.Update
+	nextUpdateID  int
+	nextMessageID int
+	calls         []Call
+	failures      map[string][]Failure
+	handlers      map[string]Handler
+	webhookURL    string
+	webhookSecret string
+	newUpdate     chan struct{}
+}
+
+// NewServer starts a fake Bot API server. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		bot: gotelegrambot.User{
+			ID:        BotUserID,
+			IsBot:     true,
+			FirstName: "Test Bot",
+			Username:  "test_bot",
+		},
+		users:         make(map[int64]gotelegrambot.User),
+		chats:         make(map[int64]gotelegrambot.Chat),
+		history:       make(map[int64][]*gotelegrambot.Message),
+		files:         make(map[string][]byte),
+		nextUpdateID:  1,
+		nextMessageID: 1,
+		failures:      make(map[string][]Failure),
+		handlers:      make(map[string]Handler),
+		newUpdate:     make(chan struct{}),
+	}
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// APIEndpoint returns the endpoint to set as Bot.APIEndpoint.
+func (s *Server) APIEndpoint() string {
+	return s.URL + "/bot" + Token
+}
+
+// BotOption points a bot at the server.
+func (s *Server) BotOption() gotelegrambot.BotOption {
+	return gotelegrambot.WithAPIEndpoint(s.URL + "/bot")
+}
+
+// AddUser registers a user along with their private chat with the bot.
+func (s *Server) AddUser(user gotelegrambot.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.users[user.ID] = user
+	if _, ok := s.chats[user.ID]; !ok {
+		s.chats[user.ID] = gotelegrambot.Chat{
+			ID:        user.ID,
+			Type:      "private",
+			FirstName: user.FirstName,
+			Username:  user.Username,
+		}
+	}
+}
+
+// AddChat registers a chat.
+func (s *Server) AddChat(chat gotelegrambot.Chat) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.chats[chat.ID] = chat
+}
+
+// AddMessage adds a message to its chat's history, keeping its ID, so the bot
+// can reply to, edit or delete it. The chat and sender are registered too.
+func (s *Server) AddMessage(message *gotelegrambot.Message) {
+	if message == nil || message.Chat == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.chats[message.Chat.ID]; !ok {
+		s.chats[message.Chat.ID] = *message.Chat
+	}
+	if message.From != nil {
+		if _, ok := s.users[message.From.ID]; !ok && message.From.ID != s.bot.ID {
+			s.users[message.From.ID] = *message.From
+		}
+	}
+
+	copied := *message
+	if existing := s.findMessage(copied.Chat.ID, copied.MessageID); existing != nil {
+		*existing = copied
+	} else {
+		s.history[copied.Chat.ID] = append(s.history[copied.Chat.ID], &copied)
+	}
+	if copied.MessageID >= s.nextMessageID {
+		s.nextMessageID = copied.MessageID + 1
+	}
+}
+
+// AddFile makes data downloadable at the given file path.
+func (s *Server) AddFile(filePath string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.files[filePath] = data
+}
+
+// Messages returns the message history of a chat, oldest first.
+func (s *Server) Messages(chatID int64) []gotelegrambot.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]gotelegrambot.Message, len(s.history[chatID]))
+	for i, message := range s.history[chatID] {
+		messages[i] = *message
+	}
+	return messages
+}
+
+// LastMessage returns the latest message of a chat, or nil if there is none.
+func (s *Server) LastMessage(chatID int64) *gotelegrambot.Message {
+	messages := s.Messages(chatID)
+	if len(messages) == 0 {
+		return nil
+	}
+	return &messages[len(messages)-1]
+}
+
+// Calls returns the recorded calls, in order.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Call(nil), s.calls...)
+}
+
+// CallsTo returns the recorded calls of a method, in order.
+func (s *Server) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range s.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// ResetCalls discards the recorded calls.
+func (s *Server) ResetCalls() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = nil
+}
+
+// Handle replaces the server's behavior for a method.
+func (s *Server) Handle(method string, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[method] = handler
+}
+
+// Fail makes the next call of the method fail. An empty method fails the
+// next call of any method. Failures queue up in order.
+func (s *Server) Fail(method string, failure Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[method] = append(s.failures[method], failure)
+}
+
+// RateLimit makes the next call of the method fail with 429 Too Many Requests.
+func (s *Server) RateLimit(method string, retryAfter int) {
+	s.Fail(method, Failure{
+		Code:        http.StatusTooManyRequests,
+		Description: "Too Many Requests: retry after " + strconv.Itoa(retryAfter),
+		RetryAfter:  retryAfter,
+	})
+}
+
+// ServerError makes the next call of the method fail with 500 Internal Server Error.
+func (s *Server) ServerError(method string) {
+	s.Fail(method, Failure{
+		Code:        http.StatusInternalServerError,
+		Description: "Internal Server Error",
+	})
+}
+
+// Conflict makes the next call of the method fail with 409 Conflict, as when
+// another instance of the bot is polling.
+func (s *Server) Conflict(method string) {
+	s.Fail(method, Failure{
+		Code:        http.StatusConflict,
+		Description: "Conflict: terminated by other getUpdates request; make sure that only one bot instance is running",
+	})
+}
+
+// InjectUpdate queues an update for the bot. A zero UpdateID is assigned the
+// next ID. When a webhook is set, the update is posted to it before
+// InjectUpdate returns.
+func (s *Server) InjectUpdate(update gotelegrambot.Update) error {
+	s.mu.Lock()
+	if update.UpdateID == 0 {
+		update.UpdateID = s.nextUpdateID
+	}
+	if update.UpdateID >= s.nextUpdateID {
+		s.nextUpdateID = update.UpdateID + 1
+	}
+	webhookURL, secret := s.webhookURL, s.webhookSecret
+	if webhookURL == "" {
+		s.updates = append(s.updates, update)
+		close(s.newUpdate)
+		s.newUpdate = make(chan struct{})
+	}
+	s.mu.Unlock()
+
+	if webhookURL == "" {
+		return nil
+	}
+	return postUpdate(webhookURL, secret, update)
+}
+
+// SendUserMessage adds a text message from a user to a chat's history and
+// injects it as an update.
+func (s *Server) SendUserMessage(chatID, userID int64, text string) (*gotelegrambot.Message, error) {
+	s.mu.Lock()
+	chat, ok := s.chats[chatID]
+	if !ok {
+		s.mu.Unlock()
+		return nil, errors.Errorf("chat %d not found", chatID)
+	}
+	user, ok := s.users[userID]
+	if !ok {
+		s.mu.Unlock()
+		return nil, errors.Errorf("user %d not found", userID)
+	}
+
+	message := s.addMessage(chat, &user, text)
+	s.mu.Unlock()
+
+	copied := *message
+	return &copied, s.InjectUpdate(gotelegrambot.Update{Message: &copied})
+}
+
+// PressButton injects a callback query from a user pressing an inline
+// button with the given data under a message of a chat.
+func (s *Server) PressButton(chatID int64, messageID int, userID int64, data string) error {
+	s.mu.Lock()
+	user, ok := s.users[userID]
+	if !ok {
+		s.mu.Unlock()
+		return errors.Errorf("user %d not found", userID)
+	}
+	message := s.findMessage(chatID, messageID)
+	if message == nil {
+		s.mu.Unlock()
+		return errors.Errorf("message %d not found in chat %d", messageID, chatID)
+	}
+	copied := *message
+	id := strconv.Itoa(s.nextUpdateID)
+	s.mu.Unlock()
+
+	return s.InjectUpdate(gotelegrambot.Update{
+		CallbackQuery: &gotelegrambot.CallbackQuery{
+			ID:           id,
+			From:         &user,
+			Message:      &copied,
+			ChatInstance: strconv.FormatInt(chatID, 10),
+			Data:         data,
+		},
+	})
+}
+
+// addMessage appends a message to the chat history. s.mu must be held.
+func (s *Server) addMessage(chat gotelegrambot.Chat, from *gotelegrambot.User, text string) *gotelegrambot.Message {
+	message := &gotelegrambot.Message{
+		MessageID: s.nextMessageID,
+		From:      from,
+		Date:      int(time.Now().Unix()),
+		Chat:      &chat,
+		Text:      text,
+	}
+	s.nextMessageID++
+	s.history[chat.ID] = append(s.history[chat.ID], message)
+	return message
+}
+
+// findMessage returns a message of the chat history. s.mu must be held.
+func (s *Server) findMessage(chatID int64, messageID int) *gotelegrambot.Message {
+	for _, message := range s.history[chatID] {
+		if message.MessageID == messageID {
+			return message
+		}
+	}
+	return nil
+}
+
+func postUpdate(url, secret string, update gotelegrambot.Update) error {
+	data, err := json.Marshal(update)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal update")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, "failed to create webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set("X-Telegram-Bot-Api-Secret-Token", secret)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to deliver update to webhook")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func badRequest(description string) error {
+	return Failure{Code: http.StatusBadRequest, Description: "Bad Request: " + description}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if filePath := strings.TrimPrefix(r.URL.Path, "/file/bot"+Token+"/"); filePath != r.URL.Path {
+		s.serveFile(w, filePath)
+		return
+	}
+
+	method := strings.TrimPrefix(r.URL.Path, "/bot"+Token+"/")
+	if method == r.URL.Path || method == "" {
+		writeResponse(w, nil, Failure{Code: http.StatusUnauthorized, Description: "Unauthorized"})
+		return
+	}
+
+	params, err := readParams(r)
+	if err != nil {
+		writeResponse(w, nil, badRequest(err.Error()))
+		return
+	}
+
+	files, err := readFiles(r)
+	if err != nil {
+		writeResponse(w, nil, badRequest(err.Error()))
+		return
+	}
+
+	call := Call{Method: method, Params: params, Files: files}
+	s.mu.Lock()
+	s.calls = append(s.calls, call)
+	failure, failed := s.nextFailure(method)
+	handler := s.handlers[method]
+	s.mu.Unlock()
+
+	if failed {
+		writeResponse(w, nil, failure)
+		return
+	}
+
+	var result interface{}
+	if handler != nil {
+		result, err = handler(call)
+	} else {
+		result, err = s.handle(r.Context(), call)
+	}
+	writeResponse(w, result, err)
+}
+
+func (s *Server) serveFile(w http.ResponseWriter, filePath string) {
+	s.mu.Lock()
+	data, ok := s.files[filePath]
+	s.mu.Unlock()
+
+	if !ok {
+		http.NotFound(w, nil)
+		return
+	}
+	w.Write(data)
+}
+
+// nextFailure pops the next queued failure of the method. s.mu must be held.
+func (s *Server) nextFailure(method string) (Failure, bool) {
+	for _, key := range []string{method, ""} {
+		if queued := s.failures[key]; len(queued) > 0 {
+			s.failures[key] = queued[1:]
+			return queued[0], true
+		}
+	}
+	return Failure{}, false
+}
+
+// readParams reads the params of a JSON, form or multipart request.
+func readParams(r *http.Request) (map[string]interface{}, error) {
+	params := make(map[string]interface{})
+	contentType := r.Header.Get("Content-Type")
+
+	switch {
+	case strings.HasPrefix(contentType, "application/json"):
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &params); err != nil {
+				return nil, errors.Wrap(err, "invalid JSON")
+			}
+		}
+	case strings.HasPrefix(contentType, "multipart/form-data"):
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return nil, err
+		}
+		for key, values := range r.MultipartForm.Value {
+			params[key] = formValue(values[0])
+		}
+		for key := range r.MultipartForm.File {
+			params[key] = "attach://" + key
+		}
+	default:
+		if err := r.ParseForm(); err != nil {
+			return nil, err
+		}
+		for key, values := range r.Form {
+			params[key] = formValue(values[0])
+		}
+	}
+
+	return params, nil
+}
+
+// readFiles reads the files uploaded with a multipart request parsed by
+// readParams.
+func readFiles(r *http.Request) (map[string][]byte, error) {
+	if r.MultipartForm == nil || len(r.MultipartForm.File) == 0 {
+		return nil, nil
+	}
+
+	files := make(map[string][]byte)
+	for key, headers := range r.MultipartForm.File {
+		file, err := headers[0].Open()
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		files[key] = data
+	}
+	return files, nil
+}
+
+// formValue decodes form values holding JSON, as the library sends non-string
+// params JSON encoded.
+func formValue(value string) interface{} {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err == nil {
+		if _, isString := decoded.(string); !isString {
+			return decoded
+		}
+	}
+	return value
+}
+
+func writeResponse(w http.ResponseWriter, result interface{}, err error) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err != nil {
+		var failure Failure
+		if !errors.As(err, &failure) {
+			failure = Failure{Code: http.StatusBadRequest, Description: "Bad Request: " + err.Error()}
+		}
+
+		response := map[string]interface{}{
+			"ok":          false,
+			"error_code":  failure.Code,
+			"description": failure.Description,
+		}
+		parameters := map[string]interface{}{}
+		if failure.RetryAfter != 0 {
+			parameters["retry_after"] = failure.RetryAfter
+		}
+		if failure.MigrateToChatID != 0 {
+			parameters["migrate_to_chat_id"] = failure.MigrateToChatID
+		}
+		if len(parameters) > 0 {
+			response["parameters"] = parameters
+		}
+
+		w.WriteHeader(failure.Code)
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if result == nil {
+		result = true
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"ok":     true,
+		"result": result,
+	})
+}
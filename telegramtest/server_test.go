@@ -0,0 +1,110 @@
+package telegramtest
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/KazeDevID/gotelegrambot"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBot(t *testing.T, server *Server, options ...gotelegrambot.BotOption) *gotelegrambot.Bot {
+	bot, err := gotelegrambot.New(Token, append([]gotelegrambot.BotOption{server.BotOption(), gotelegrambot.WithRetryCount(1)}, options...)...)
+	require.NoError(t, err)
+	return bot
+}
+
+func TestServerPolling(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddUser(gotelegrambot.User{ID: 42, FirstName: "Alice"})
+
+	bot := newBot(t, server)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	replied := make(chan struct{})
+	err := bot.StartPolling(ctx, func(ctx context.Context, update *gotelegrambot.Update) error {
+		_, err := bot.SendMessage(ctx, update.Message.ChatID(), "echo: "+update.Message.Text)
+		close(replied)
+		return err
+	}, gotelegrambot.WithTimeout(1))
+	require.NoError(t, err)
+
+	_, err = server.SendUserMessage(42, 42, "hello")
+	require.NoError(t, err)
+
+	select {
+	case <-replied:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no reply")
+	}
+
+	messages := server.Messages(42)
+	require.Len(t, messages, 2)
+	assert.Equal(t, "echo: hello", messages[1].Text)
+
+	calls := server.CallsTo("sendMessage")
+	require.Len(t, calls, 1)
+	assert.Equal(t, int64(42), calls[0].Int64("chat_id"))
+}
+
+func TestServerWebhook(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddUser(gotelegrambot.User{ID: 42, FirstName: "Alice"})
+
+	bot := newBot(t, server)
+	var received []string
+	webhook := httptest.NewServer(bot.WebhookHandler(func(ctx context.Context, update *gotelegrambot.Update) error {
+		received = append(received, update.Message.Text)
+		return nil
+	}))
+	defer webhook.Close()
+
+	// Updates queued before the webhook is set are delivered to it
+	_, err := server.SendUserMessage(42, 42, "first")
+	require.NoError(t, err)
+	require.NoError(t, bot.SetWebhook(context.Background(), gotelegrambot.WebhookConfig{URL: webhook.URL}))
+	_, err = server.SendUserMessage(42, 42, "second")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "second"}, received)
+
+	info, err := bot.GetWebhookInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, webhook.URL, info.URL)
+}
+
+func TestServerErrors(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.AddUser(gotelegrambot.User{ID: 42, FirstName: "Alice"})
+	bot := newBot(t, server)
+	ctx := context.Background()
+
+	_, err := bot.SendMessage(ctx, gotelegrambot.NewChatID(7), "hello")
+	assert.True(t, errors.Is(err, gotelegrambot.ErrChatNotFound))
+
+	message, err := bot.SendMessage(ctx, gotelegrambot.NewChatID(42), "hello")
+	require.NoError(t, err)
+	_, err = bot.EditMessageText(ctx, gotelegrambot.WithChatID(gotelegrambot.NewChatID(42)), gotelegrambot.WithMessageID(message.MessageID), gotelegrambot.WithText("hello"))
+	assert.True(t, errors.Is(err, gotelegrambot.ErrMessageNotModified))
+
+	// A 5xx is answered as is, a 429 is retried after retry_after
+	server.ServerError("sendMessage")
+	_, err = bot.SendMessage(ctx, gotelegrambot.NewChatID(42), "hello")
+	assert.True(t, errors.Is(err, gotelegrambot.ErrServerError))
+
+	server.RateLimit("sendMessage", 1)
+	_, err = bot.SendMessage(ctx, gotelegrambot.NewChatID(42), "hello")
+	require.NoError(t, err)
+	assert.Len(t, server.CallsTo("sendMessage"), 5)
+
+	server.Conflict("")
+	_, err = bot.GetWebhookInfo(ctx)
+	assert.True(t, errors.Is(err, gotelegrambot.ErrConflict))
+}
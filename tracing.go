@@ -0,0 +1,185 @@
+package gotelegrambot
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Tracer starts spans. It is the bot's hook into a tracing system; adapters
+// for OpenTelemetry or similar libraries implement it.
+type Tracer interface {
+	// Start starts a span as a child of the span in ctx, if any, and returns
+	// a context carrying the new span.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a unit of traced work.
+type Span interface {
+	// SetAttributes adds attributes to the span.
+	SetAttributes(attrs ...Attribute)
+	// RecordError marks the span as failed.
+	RecordError(err error)
+	// End completes the span.
+	End()
+}
+
+// Attribute is a key and value attached to a span.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Attr creates an Attribute.
+func Attr(key string, value interface{}) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Span names and attribute keys used by the bot.
+const (
+	SpanUpdate  = "telegram.update"
+	SpanRequest = "telegram.request"
+
+	AttrUpdateID       = "telegram.update_id"
+	AttrUpdateType     = "telegram.update_type"
+	AttrMethod         = "telegram.method"
+	AttrErrorCode      = "telegram.error_code"
+	AttrHTTPStatusCode = "http.status_code"
+)
+
+// WithTracer sets the Tracer used to trace updates and API calls.
+func WithTracer(tracer Tracer) BotOption {
+	return func(b *Bot) {
+		b.tracer = tracer
+	}
+}
+
+// startSpan starts a span with the configured tracer, or a no-op span.
+func (b *Bot) startSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	if b.tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	ctx, span := b.tracer.Start(ctx, name, attrs...)
+	return context.WithValue(ctx, requestSpanKey{}, span), span
+}
+
+// requestSpanKey is the context key of the current span, so that the HTTP
+// layer can attach the response status to it.
+type requestSpanKey struct{}
+
+// spanFromContext returns the span started by the bot in ctx, or a no-op span.
+func spanFromContext(ctx context.Context) Span {
+	if span, ok := ctx.Value(requestSpanKey{}).(Span); ok {
+		return span
+	}
+	return noopSpan{}
+}
+
+// noopSpan discards everything.
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(...Attribute) {}
+func (noopSpan) RecordError(error)          {}
+func (noopSpan) End()                       {}
+
+// RecordedSpan is a span recorded by a SpanRecorder.
+type RecordedSpan struct {
+	ID         int
+	ParentID   int
+	Name       string
+	Attributes map[string]interface{}
+	Err        error
+	Start      time.Time
+	End        time.Time
+	Ended      bool
+}
+
+// SpanRecorder is a Tracer keeping spans in memory, for tests and debugging.
+type SpanRecorder struct {
+	mu    sync.Mutex
+	spans []*RecordedSpan
+}
+
+// NewSpanRecorder creates an empty SpanRecorder.
+func NewSpanRecorder() *SpanRecorder {
+	return &SpanRecorder{}
+}
+
+// recorderParentKey is the context key of the recorder's current span.
+type recorderParentKey struct{}
+
+// Start starts a span as a child of the recorder's span in ctx.
+func (r *SpanRecorder) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	parentID, _ := ctx.Value(recorderParentKey{}).(int)
+	span := &RecordedSpan{
+		ID:         len(r.spans) + 1,
+		ParentID:   parentID,
+		Name:       name,
+		Attributes: make(map[string]interface{}),
+		Start:      time.Now(),
+	}
+	for _, attr := range attrs {
+		span.Attributes[attr.Key] = attr.Value
+	}
+	r.spans = append(r.spans, span)
+
+	return context.WithValue(ctx, recorderParentKey{}, span.ID), &recordingSpan{recorder: r, span: span}
+}
+
+// Spans returns copies of the recorded spans, in start order.
+func (r *SpanRecorder) Spans() []RecordedSpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	spans := make([]RecordedSpan, len(r.spans))
+	for i, span := range r.spans {
+		spans[i] = *span
+		spans[i].Attributes = make(map[string]interface{}, len(span.Attributes))
+		for key, value := range span.Attributes {
+			spans[i].Attributes[key] = value
+		}
+	}
+	return spans
+}
+
+// Reset discards the recorded spans.
+func (r *SpanRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.spans = nil
+}
+
+// recordingSpan updates a RecordedSpan under the recorder's lock.
+type recordingSpan struct {
+	recorder *SpanRecorder
+	span     *RecordedSpan
+}
+
+func (s *recordingSpan) SetAttributes(attrs ...Attribute) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+
+	for _, attr := range attrs {
+		s.span.Attributes[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordingSpan) RecordError(err error) {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+
+	s.span.Err = err
+}
+
+func (s *recordingSpan) End() {
+	s.recorder.mu.Lock()
+	defer s.recorder.mu.Unlock()
+
+	s.span.End = time.Now()
+	s.span.Ended = true
+}
@@ -0,0 +1,43 @@
+package gotelegrambot
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok":true,"result":{"message_id":1,"date":0}}`))
+	}))
+	defer server.Close()
+
+	recorder := NewSpanRecorder()
+	bot, _ := New("test_token", WithTracer(recorder))
+	bot.APIEndpoint = server.URL
+
+	update := &Update{UpdateID: 5, Message: &Message{Text: "hi"}}
+	err := bot.handleUpdate(context.Background(), update, func(ctx context.Context, update *Update) error {
+		_, err := bot.SendMessage(ctx, NewChatID(1), "hello")
+		return err
+	})
+	require.NoError(t, err)
+
+	spans := recorder.Spans()
+	require.Len(t, spans, 2)
+
+	assert.Equal(t, SpanUpdate, spans[0].Name)
+	assert.Equal(t, 5, spans[0].Attributes[AttrUpdateID])
+	assert.Equal(t, UpdateTypeMessage, spans[0].Attributes[AttrUpdateType])
+	assert.True(t, spans[0].Ended)
+
+	assert.Equal(t, SpanRequest, spans[1].Name)
+	assert.Equal(t, spans[0].ID, spans[1].ParentID)
+	assert.Equal(t, "sendMessage", spans[1].Attributes[AttrMethod])
+	assert.Equal(t, http.StatusOK, spans[1].Attributes[AttrHTTPStatusCode])
+	assert.True(t, spans[1].Ended)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 )
@@ -20,72 +19,33 @@ type WebhookConfig struct {
 	SecretToken        string
 }
 
-// SetWebhook sets a webhook for receiving updates.
+// SetWebhook sets a webhook for receiving updates. The allowed updates include
+// the ones needed by the registered handlers.
 func (b *Bot) SetWebhook(ctx context.Context, config WebhookConfig) error {
-	params := map[string]interface{}{
-		"url": config.URL,
+	params := SetWebhookParams{
+		URL:                config.URL,
+		Certificate:        config.Certificate,
+		IPAddress:          config.IPAddress,
+		AllowedUpdates:     b.allowedUpdates(config.AllowedUpdates),
+		DropPendingUpdates: config.DropPendingUpdates,
+		SecretToken:        config.SecretToken,
 	}
-	
-	if config.Certificate != nil {
-		params["certificate"] = config.Certificate
-	}
-	
-	if config.IPAddress != "" {
-		params["ip_address"] = config.IPAddress
-	}
-	
+
 	if config.MaxConnections != 0 {
-		params["max_connections"] = config.MaxConnections
-	}
-	
-	if len(config.AllowedUpdates) > 0 {
-		params["allowed_updates"] = config.AllowedUpdates
-	}
-	
-	if config.DropPendingUpdates {
-		params["drop_pending_updates"] = true
+		params.MaxConnections = &config.MaxConnections
 	}
-	
-	if config.SecretToken != "" {
-		params["secret_token"] = config.SecretToken
-	}
-	
-	// TODO: Make the actual API request
-	// This is a placeholder
-	return nil
+
+	return params.do(ctx, b)
 }
 
 // DeleteWebhook deletes the webhook.
 func (b *Bot) DeleteWebhook(ctx context.Context, dropPendingUpdates bool) error {
-	params := map[string]interface{}{}
-	
-	if dropPendingUpdates {
-		params["drop_pending_updates"] = true
-	}
-	
-	// TODO: Make the actual API request
-	// This is a placeholder
-	return nil
+	return DeleteWebhookParams{DropPendingUpdates: dropPendingUpdates}.do(ctx, b)
 }
 
 // GetWebhookInfo gets current webhook status.
 func (b *Bot) GetWebhookInfo(ctx context.Context) (*WebhookInfo, error) {
-	// TODO: Make the actual API request
-	// This is a placeholder
-	return nil, nil
-}
-
-// WebhookInfo contains information about the current status of a webhook.
-type WebhookInfo struct {
-	URL                  string   `json:"url"`
-	HasCustomCertificate bool     `json:"has_custom_certificate"`
-	PendingUpdateCount   int      `json:"pending_update_count"`
-	IPAddress            string   `json:"ip_address,omitempty"`
-	LastErrorDate        int      `json:"last_error_date,omitempty"`
-	LastErrorMessage     string   `json:"last_error_message,omitempty"`
-	LastSynchronizationErrorDate int `json:"last_synchronization_error_date,omitempty"`
-	MaxConnections       int      `json:"max_connections,omitempty"`
-	AllowedUpdates       []string `json:"allowed_updates,omitempty"`
+	return GetWebhookInfoParams{}.do(ctx, b)
 }
 
 // WebhookHandler creates an http.Handler for processing webhook requests.
@@ -105,13 +65,13 @@ func (b *Bot) WebhookHandler(handler UpdateHandler) http.Handler {
 		
 		var update Update
 		if err := json.Unmarshal(body, &update); err != nil {
-			b.debug("Error unmarshaling update: %v", err)
+			b.log(LogLevelWarn, "parsing webhook update failed", "error", err)
 			http.Error(w, "Error parsing update", http.StatusBadRequest)
 			return
 		}
 		
-		if err := handler(r.Context(), &update); err != nil {
-			b.debug("Error handling update: %v", err)
+		if err := b.handleUpdate(r.Context(), &update, handler); err != nil {
+			b.log(LogLevelError, "processing update failed", "update_id", update.UpdateID, "error", err)
 			http.Error(w, "Error processing update", http.StatusInternalServerError)
 			return
 		}